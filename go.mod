@@ -0,0 +1,11 @@
+module github.com/kostya-sh/parquet-go
+
+go 1.25
+
+require (
+	github.com/andybalholm/brotli v1.2.6
+	github.com/golang/snappy v1.0.0
+	github.com/klauspost/compress v1.20.1
+	github.com/pierrec/lz4/v4 v4.1.31
+	github.com/rasky/go-lzo v0.0.0-20200203143853-96a758eda86e
+)
@@ -417,3 +417,659 @@ func unpack8int32_32(data []byte) (a [8]int32) {
 	a[7] = int32(uint32((data[28]>>0)&255)<<0 | uint32((data[29]>>0)&255)<<8 | uint32((data[30]>>0)&255)<<16 | uint32((data[31]>>0)&255)<<24)
 	return
 }
+
+func pack8int32_1(data []byte, a [8]int32) {
+	_ = data[0]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<1) | byte(uint32(a[2])<<2) | byte(uint32(a[3])<<3) | byte(uint32(a[4])<<4) | byte(uint32(a[5])<<5) | byte(uint32(a[6])<<6) | byte(uint32(a[7])<<7)
+}
+
+func pack8int32_2(data []byte, a [8]int32) {
+	_ = data[1]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<2) | byte(uint32(a[2])<<4) | byte(uint32(a[3])<<6)
+	data[1] = byte(uint32(a[4])<<0) | byte(uint32(a[5])<<2) | byte(uint32(a[6])<<4) | byte(uint32(a[7])<<6)
+}
+
+func pack8int32_3(data []byte, a [8]int32) {
+	_ = data[2]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<3) | byte(uint32(a[2])<<6)
+	data[1] = byte(uint32(a[2])>>2) | byte(uint32(a[3])<<1) | byte(uint32(a[4])<<4) | byte(uint32(a[5])<<7)
+	data[2] = byte(uint32(a[5])>>1) | byte(uint32(a[6])<<2) | byte(uint32(a[7])<<5)
+}
+
+func pack8int32_4(data []byte, a [8]int32) {
+	_ = data[3]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<4)
+	data[1] = byte(uint32(a[2])<<0) | byte(uint32(a[3])<<4)
+	data[2] = byte(uint32(a[4])<<0) | byte(uint32(a[5])<<4)
+	data[3] = byte(uint32(a[6])<<0) | byte(uint32(a[7])<<4)
+}
+
+func pack8int32_5(data []byte, a [8]int32) {
+	_ = data[4]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<5)
+	data[1] = byte(uint32(a[1])>>3) | byte(uint32(a[2])<<2) | byte(uint32(a[3])<<7)
+	data[2] = byte(uint32(a[3])>>1) | byte(uint32(a[4])<<4)
+	data[3] = byte(uint32(a[4])>>4) | byte(uint32(a[5])<<1) | byte(uint32(a[6])<<6)
+	data[4] = byte(uint32(a[6])>>2) | byte(uint32(a[7])<<3)
+}
+
+func pack8int32_6(data []byte, a [8]int32) {
+	_ = data[5]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<6)
+	data[1] = byte(uint32(a[1])>>2) | byte(uint32(a[2])<<4)
+	data[2] = byte(uint32(a[2])>>4) | byte(uint32(a[3])<<2)
+	data[3] = byte(uint32(a[4])<<0) | byte(uint32(a[5])<<6)
+	data[4] = byte(uint32(a[5])>>2) | byte(uint32(a[6])<<4)
+	data[5] = byte(uint32(a[6])>>4) | byte(uint32(a[7])<<2)
+}
+
+func pack8int32_7(data []byte, a [8]int32) {
+	_ = data[6]
+	data[0] = byte(uint32(a[0])<<0) | byte(uint32(a[1])<<7)
+	data[1] = byte(uint32(a[1])>>1) | byte(uint32(a[2])<<6)
+	data[2] = byte(uint32(a[2])>>2) | byte(uint32(a[3])<<5)
+	data[3] = byte(uint32(a[3])>>3) | byte(uint32(a[4])<<4)
+	data[4] = byte(uint32(a[4])>>4) | byte(uint32(a[5])<<3)
+	data[5] = byte(uint32(a[5])>>5) | byte(uint32(a[6])<<2)
+	data[6] = byte(uint32(a[6])>>6) | byte(uint32(a[7])<<1)
+}
+
+func pack8int32_8(data []byte, a [8]int32) {
+	_ = data[7]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[1]) << 0)
+	data[2] = byte(uint32(a[2]) << 0)
+	data[3] = byte(uint32(a[3]) << 0)
+	data[4] = byte(uint32(a[4]) << 0)
+	data[5] = byte(uint32(a[5]) << 0)
+	data[6] = byte(uint32(a[6]) << 0)
+	data[7] = byte(uint32(a[7]) << 0)
+}
+
+func pack8int32_9(data []byte, a [8]int32) {
+	_ = data[8]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<1)
+	data[2] = byte(uint32(a[1])>>7) | byte(uint32(a[2])<<2)
+	data[3] = byte(uint32(a[2])>>6) | byte(uint32(a[3])<<3)
+	data[4] = byte(uint32(a[3])>>5) | byte(uint32(a[4])<<4)
+	data[5] = byte(uint32(a[4])>>4) | byte(uint32(a[5])<<5)
+	data[6] = byte(uint32(a[5])>>3) | byte(uint32(a[6])<<6)
+	data[7] = byte(uint32(a[6])>>2) | byte(uint32(a[7])<<7)
+	data[8] = byte(uint32(a[7]) >> 1)
+}
+
+func pack8int32_10(data []byte, a [8]int32) {
+	_ = data[9]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<2)
+	data[2] = byte(uint32(a[1])>>6) | byte(uint32(a[2])<<4)
+	data[3] = byte(uint32(a[2])>>4) | byte(uint32(a[3])<<6)
+	data[4] = byte(uint32(a[3]) >> 2)
+	data[5] = byte(uint32(a[4]) << 0)
+	data[6] = byte(uint32(a[4])>>8) | byte(uint32(a[5])<<2)
+	data[7] = byte(uint32(a[5])>>6) | byte(uint32(a[6])<<4)
+	data[8] = byte(uint32(a[6])>>4) | byte(uint32(a[7])<<6)
+	data[9] = byte(uint32(a[7]) >> 2)
+}
+
+func pack8int32_11(data []byte, a [8]int32) {
+	_ = data[10]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<3)
+	data[2] = byte(uint32(a[1])>>5) | byte(uint32(a[2])<<6)
+	data[3] = byte(uint32(a[2]) >> 2)
+	data[4] = byte(uint32(a[2])>>10) | byte(uint32(a[3])<<1)
+	data[5] = byte(uint32(a[3])>>7) | byte(uint32(a[4])<<4)
+	data[6] = byte(uint32(a[4])>>4) | byte(uint32(a[5])<<7)
+	data[7] = byte(uint32(a[5]) >> 1)
+	data[8] = byte(uint32(a[5])>>9) | byte(uint32(a[6])<<2)
+	data[9] = byte(uint32(a[6])>>6) | byte(uint32(a[7])<<5)
+	data[10] = byte(uint32(a[7]) >> 3)
+}
+
+func pack8int32_12(data []byte, a [8]int32) {
+	_ = data[11]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<4)
+	data[2] = byte(uint32(a[1]) >> 4)
+	data[3] = byte(uint32(a[2]) << 0)
+	data[4] = byte(uint32(a[2])>>8) | byte(uint32(a[3])<<4)
+	data[5] = byte(uint32(a[3]) >> 4)
+	data[6] = byte(uint32(a[4]) << 0)
+	data[7] = byte(uint32(a[4])>>8) | byte(uint32(a[5])<<4)
+	data[8] = byte(uint32(a[5]) >> 4)
+	data[9] = byte(uint32(a[6]) << 0)
+	data[10] = byte(uint32(a[6])>>8) | byte(uint32(a[7])<<4)
+	data[11] = byte(uint32(a[7]) >> 4)
+}
+
+func pack8int32_13(data []byte, a [8]int32) {
+	_ = data[12]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<5)
+	data[2] = byte(uint32(a[1]) >> 3)
+	data[3] = byte(uint32(a[1])>>11) | byte(uint32(a[2])<<2)
+	data[4] = byte(uint32(a[2])>>6) | byte(uint32(a[3])<<7)
+	data[5] = byte(uint32(a[3]) >> 1)
+	data[6] = byte(uint32(a[3])>>9) | byte(uint32(a[4])<<4)
+	data[7] = byte(uint32(a[4]) >> 4)
+	data[8] = byte(uint32(a[4])>>12) | byte(uint32(a[5])<<1)
+	data[9] = byte(uint32(a[5])>>7) | byte(uint32(a[6])<<6)
+	data[10] = byte(uint32(a[6]) >> 2)
+	data[11] = byte(uint32(a[6])>>10) | byte(uint32(a[7])<<3)
+	data[12] = byte(uint32(a[7]) >> 5)
+}
+
+func pack8int32_14(data []byte, a [8]int32) {
+	_ = data[13]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<6)
+	data[2] = byte(uint32(a[1]) >> 2)
+	data[3] = byte(uint32(a[1])>>10) | byte(uint32(a[2])<<4)
+	data[4] = byte(uint32(a[2]) >> 4)
+	data[5] = byte(uint32(a[2])>>12) | byte(uint32(a[3])<<2)
+	data[6] = byte(uint32(a[3]) >> 6)
+	data[7] = byte(uint32(a[4]) << 0)
+	data[8] = byte(uint32(a[4])>>8) | byte(uint32(a[5])<<6)
+	data[9] = byte(uint32(a[5]) >> 2)
+	data[10] = byte(uint32(a[5])>>10) | byte(uint32(a[6])<<4)
+	data[11] = byte(uint32(a[6]) >> 4)
+	data[12] = byte(uint32(a[6])>>12) | byte(uint32(a[7])<<2)
+	data[13] = byte(uint32(a[7]) >> 6)
+}
+
+func pack8int32_15(data []byte, a [8]int32) {
+	_ = data[14]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0])>>8) | byte(uint32(a[1])<<7)
+	data[2] = byte(uint32(a[1]) >> 1)
+	data[3] = byte(uint32(a[1])>>9) | byte(uint32(a[2])<<6)
+	data[4] = byte(uint32(a[2]) >> 2)
+	data[5] = byte(uint32(a[2])>>10) | byte(uint32(a[3])<<5)
+	data[6] = byte(uint32(a[3]) >> 3)
+	data[7] = byte(uint32(a[3])>>11) | byte(uint32(a[4])<<4)
+	data[8] = byte(uint32(a[4]) >> 4)
+	data[9] = byte(uint32(a[4])>>12) | byte(uint32(a[5])<<3)
+	data[10] = byte(uint32(a[5]) >> 5)
+	data[11] = byte(uint32(a[5])>>13) | byte(uint32(a[6])<<2)
+	data[12] = byte(uint32(a[6]) >> 6)
+	data[13] = byte(uint32(a[6])>>14) | byte(uint32(a[7])<<1)
+	data[14] = byte(uint32(a[7]) >> 7)
+}
+
+func pack8int32_16(data []byte, a [8]int32) {
+	_ = data[15]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[1]) << 0)
+	data[3] = byte(uint32(a[1]) >> 8)
+	data[4] = byte(uint32(a[2]) << 0)
+	data[5] = byte(uint32(a[2]) >> 8)
+	data[6] = byte(uint32(a[3]) << 0)
+	data[7] = byte(uint32(a[3]) >> 8)
+	data[8] = byte(uint32(a[4]) << 0)
+	data[9] = byte(uint32(a[4]) >> 8)
+	data[10] = byte(uint32(a[5]) << 0)
+	data[11] = byte(uint32(a[5]) >> 8)
+	data[12] = byte(uint32(a[6]) << 0)
+	data[13] = byte(uint32(a[6]) >> 8)
+	data[14] = byte(uint32(a[7]) << 0)
+	data[15] = byte(uint32(a[7]) >> 8)
+}
+
+func pack8int32_17(data []byte, a [8]int32) {
+	_ = data[16]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<1)
+	data[3] = byte(uint32(a[1]) >> 7)
+	data[4] = byte(uint32(a[1])>>15) | byte(uint32(a[2])<<2)
+	data[5] = byte(uint32(a[2]) >> 6)
+	data[6] = byte(uint32(a[2])>>14) | byte(uint32(a[3])<<3)
+	data[7] = byte(uint32(a[3]) >> 5)
+	data[8] = byte(uint32(a[3])>>13) | byte(uint32(a[4])<<4)
+	data[9] = byte(uint32(a[4]) >> 4)
+	data[10] = byte(uint32(a[4])>>12) | byte(uint32(a[5])<<5)
+	data[11] = byte(uint32(a[5]) >> 3)
+	data[12] = byte(uint32(a[5])>>11) | byte(uint32(a[6])<<6)
+	data[13] = byte(uint32(a[6]) >> 2)
+	data[14] = byte(uint32(a[6])>>10) | byte(uint32(a[7])<<7)
+	data[15] = byte(uint32(a[7]) >> 1)
+	data[16] = byte(uint32(a[7]) >> 9)
+}
+
+func pack8int32_18(data []byte, a [8]int32) {
+	_ = data[17]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<2)
+	data[3] = byte(uint32(a[1]) >> 6)
+	data[4] = byte(uint32(a[1])>>14) | byte(uint32(a[2])<<4)
+	data[5] = byte(uint32(a[2]) >> 4)
+	data[6] = byte(uint32(a[2])>>12) | byte(uint32(a[3])<<6)
+	data[7] = byte(uint32(a[3]) >> 2)
+	data[8] = byte(uint32(a[3]) >> 10)
+	data[9] = byte(uint32(a[4]) << 0)
+	data[10] = byte(uint32(a[4]) >> 8)
+	data[11] = byte(uint32(a[4])>>16) | byte(uint32(a[5])<<2)
+	data[12] = byte(uint32(a[5]) >> 6)
+	data[13] = byte(uint32(a[5])>>14) | byte(uint32(a[6])<<4)
+	data[14] = byte(uint32(a[6]) >> 4)
+	data[15] = byte(uint32(a[6])>>12) | byte(uint32(a[7])<<6)
+	data[16] = byte(uint32(a[7]) >> 2)
+	data[17] = byte(uint32(a[7]) >> 10)
+}
+
+func pack8int32_19(data []byte, a [8]int32) {
+	_ = data[18]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<3)
+	data[3] = byte(uint32(a[1]) >> 5)
+	data[4] = byte(uint32(a[1])>>13) | byte(uint32(a[2])<<6)
+	data[5] = byte(uint32(a[2]) >> 2)
+	data[6] = byte(uint32(a[2]) >> 10)
+	data[7] = byte(uint32(a[2])>>18) | byte(uint32(a[3])<<1)
+	data[8] = byte(uint32(a[3]) >> 7)
+	data[9] = byte(uint32(a[3])>>15) | byte(uint32(a[4])<<4)
+	data[10] = byte(uint32(a[4]) >> 4)
+	data[11] = byte(uint32(a[4])>>12) | byte(uint32(a[5])<<7)
+	data[12] = byte(uint32(a[5]) >> 1)
+	data[13] = byte(uint32(a[5]) >> 9)
+	data[14] = byte(uint32(a[5])>>17) | byte(uint32(a[6])<<2)
+	data[15] = byte(uint32(a[6]) >> 6)
+	data[16] = byte(uint32(a[6])>>14) | byte(uint32(a[7])<<5)
+	data[17] = byte(uint32(a[7]) >> 3)
+	data[18] = byte(uint32(a[7]) >> 11)
+}
+
+func pack8int32_20(data []byte, a [8]int32) {
+	_ = data[19]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<4)
+	data[3] = byte(uint32(a[1]) >> 4)
+	data[4] = byte(uint32(a[1]) >> 12)
+	data[5] = byte(uint32(a[2]) << 0)
+	data[6] = byte(uint32(a[2]) >> 8)
+	data[7] = byte(uint32(a[2])>>16) | byte(uint32(a[3])<<4)
+	data[8] = byte(uint32(a[3]) >> 4)
+	data[9] = byte(uint32(a[3]) >> 12)
+	data[10] = byte(uint32(a[4]) << 0)
+	data[11] = byte(uint32(a[4]) >> 8)
+	data[12] = byte(uint32(a[4])>>16) | byte(uint32(a[5])<<4)
+	data[13] = byte(uint32(a[5]) >> 4)
+	data[14] = byte(uint32(a[5]) >> 12)
+	data[15] = byte(uint32(a[6]) << 0)
+	data[16] = byte(uint32(a[6]) >> 8)
+	data[17] = byte(uint32(a[6])>>16) | byte(uint32(a[7])<<4)
+	data[18] = byte(uint32(a[7]) >> 4)
+	data[19] = byte(uint32(a[7]) >> 12)
+}
+
+func pack8int32_21(data []byte, a [8]int32) {
+	_ = data[20]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<5)
+	data[3] = byte(uint32(a[1]) >> 3)
+	data[4] = byte(uint32(a[1]) >> 11)
+	data[5] = byte(uint32(a[1])>>19) | byte(uint32(a[2])<<2)
+	data[6] = byte(uint32(a[2]) >> 6)
+	data[7] = byte(uint32(a[2])>>14) | byte(uint32(a[3])<<7)
+	data[8] = byte(uint32(a[3]) >> 1)
+	data[9] = byte(uint32(a[3]) >> 9)
+	data[10] = byte(uint32(a[3])>>17) | byte(uint32(a[4])<<4)
+	data[11] = byte(uint32(a[4]) >> 4)
+	data[12] = byte(uint32(a[4]) >> 12)
+	data[13] = byte(uint32(a[4])>>20) | byte(uint32(a[5])<<1)
+	data[14] = byte(uint32(a[5]) >> 7)
+	data[15] = byte(uint32(a[5])>>15) | byte(uint32(a[6])<<6)
+	data[16] = byte(uint32(a[6]) >> 2)
+	data[17] = byte(uint32(a[6]) >> 10)
+	data[18] = byte(uint32(a[6])>>18) | byte(uint32(a[7])<<3)
+	data[19] = byte(uint32(a[7]) >> 5)
+	data[20] = byte(uint32(a[7]) >> 13)
+}
+
+func pack8int32_22(data []byte, a [8]int32) {
+	_ = data[21]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<6)
+	data[3] = byte(uint32(a[1]) >> 2)
+	data[4] = byte(uint32(a[1]) >> 10)
+	data[5] = byte(uint32(a[1])>>18) | byte(uint32(a[2])<<4)
+	data[6] = byte(uint32(a[2]) >> 4)
+	data[7] = byte(uint32(a[2]) >> 12)
+	data[8] = byte(uint32(a[2])>>20) | byte(uint32(a[3])<<2)
+	data[9] = byte(uint32(a[3]) >> 6)
+	data[10] = byte(uint32(a[3]) >> 14)
+	data[11] = byte(uint32(a[4]) << 0)
+	data[12] = byte(uint32(a[4]) >> 8)
+	data[13] = byte(uint32(a[4])>>16) | byte(uint32(a[5])<<6)
+	data[14] = byte(uint32(a[5]) >> 2)
+	data[15] = byte(uint32(a[5]) >> 10)
+	data[16] = byte(uint32(a[5])>>18) | byte(uint32(a[6])<<4)
+	data[17] = byte(uint32(a[6]) >> 4)
+	data[18] = byte(uint32(a[6]) >> 12)
+	data[19] = byte(uint32(a[6])>>20) | byte(uint32(a[7])<<2)
+	data[20] = byte(uint32(a[7]) >> 6)
+	data[21] = byte(uint32(a[7]) >> 14)
+}
+
+func pack8int32_23(data []byte, a [8]int32) {
+	_ = data[22]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0])>>16) | byte(uint32(a[1])<<7)
+	data[3] = byte(uint32(a[1]) >> 1)
+	data[4] = byte(uint32(a[1]) >> 9)
+	data[5] = byte(uint32(a[1])>>17) | byte(uint32(a[2])<<6)
+	data[6] = byte(uint32(a[2]) >> 2)
+	data[7] = byte(uint32(a[2]) >> 10)
+	data[8] = byte(uint32(a[2])>>18) | byte(uint32(a[3])<<5)
+	data[9] = byte(uint32(a[3]) >> 3)
+	data[10] = byte(uint32(a[3]) >> 11)
+	data[11] = byte(uint32(a[3])>>19) | byte(uint32(a[4])<<4)
+	data[12] = byte(uint32(a[4]) >> 4)
+	data[13] = byte(uint32(a[4]) >> 12)
+	data[14] = byte(uint32(a[4])>>20) | byte(uint32(a[5])<<3)
+	data[15] = byte(uint32(a[5]) >> 5)
+	data[16] = byte(uint32(a[5]) >> 13)
+	data[17] = byte(uint32(a[5])>>21) | byte(uint32(a[6])<<2)
+	data[18] = byte(uint32(a[6]) >> 6)
+	data[19] = byte(uint32(a[6]) >> 14)
+	data[20] = byte(uint32(a[6])>>22) | byte(uint32(a[7])<<1)
+	data[21] = byte(uint32(a[7]) >> 7)
+	data[22] = byte(uint32(a[7]) >> 15)
+}
+
+func pack8int32_24(data []byte, a [8]int32) {
+	_ = data[23]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[1]) << 0)
+	data[4] = byte(uint32(a[1]) >> 8)
+	data[5] = byte(uint32(a[1]) >> 16)
+	data[6] = byte(uint32(a[2]) << 0)
+	data[7] = byte(uint32(a[2]) >> 8)
+	data[8] = byte(uint32(a[2]) >> 16)
+	data[9] = byte(uint32(a[3]) << 0)
+	data[10] = byte(uint32(a[3]) >> 8)
+	data[11] = byte(uint32(a[3]) >> 16)
+	data[12] = byte(uint32(a[4]) << 0)
+	data[13] = byte(uint32(a[4]) >> 8)
+	data[14] = byte(uint32(a[4]) >> 16)
+	data[15] = byte(uint32(a[5]) << 0)
+	data[16] = byte(uint32(a[5]) >> 8)
+	data[17] = byte(uint32(a[5]) >> 16)
+	data[18] = byte(uint32(a[6]) << 0)
+	data[19] = byte(uint32(a[6]) >> 8)
+	data[20] = byte(uint32(a[6]) >> 16)
+	data[21] = byte(uint32(a[7]) << 0)
+	data[22] = byte(uint32(a[7]) >> 8)
+	data[23] = byte(uint32(a[7]) >> 16)
+}
+
+func pack8int32_25(data []byte, a [8]int32) {
+	_ = data[24]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<1)
+	data[4] = byte(uint32(a[1]) >> 7)
+	data[5] = byte(uint32(a[1]) >> 15)
+	data[6] = byte(uint32(a[1])>>23) | byte(uint32(a[2])<<2)
+	data[7] = byte(uint32(a[2]) >> 6)
+	data[8] = byte(uint32(a[2]) >> 14)
+	data[9] = byte(uint32(a[2])>>22) | byte(uint32(a[3])<<3)
+	data[10] = byte(uint32(a[3]) >> 5)
+	data[11] = byte(uint32(a[3]) >> 13)
+	data[12] = byte(uint32(a[3])>>21) | byte(uint32(a[4])<<4)
+	data[13] = byte(uint32(a[4]) >> 4)
+	data[14] = byte(uint32(a[4]) >> 12)
+	data[15] = byte(uint32(a[4])>>20) | byte(uint32(a[5])<<5)
+	data[16] = byte(uint32(a[5]) >> 3)
+	data[17] = byte(uint32(a[5]) >> 11)
+	data[18] = byte(uint32(a[5])>>19) | byte(uint32(a[6])<<6)
+	data[19] = byte(uint32(a[6]) >> 2)
+	data[20] = byte(uint32(a[6]) >> 10)
+	data[21] = byte(uint32(a[6])>>18) | byte(uint32(a[7])<<7)
+	data[22] = byte(uint32(a[7]) >> 1)
+	data[23] = byte(uint32(a[7]) >> 9)
+	data[24] = byte(uint32(a[7]) >> 17)
+}
+
+func pack8int32_26(data []byte, a [8]int32) {
+	_ = data[25]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<2)
+	data[4] = byte(uint32(a[1]) >> 6)
+	data[5] = byte(uint32(a[1]) >> 14)
+	data[6] = byte(uint32(a[1])>>22) | byte(uint32(a[2])<<4)
+	data[7] = byte(uint32(a[2]) >> 4)
+	data[8] = byte(uint32(a[2]) >> 12)
+	data[9] = byte(uint32(a[2])>>20) | byte(uint32(a[3])<<6)
+	data[10] = byte(uint32(a[3]) >> 2)
+	data[11] = byte(uint32(a[3]) >> 10)
+	data[12] = byte(uint32(a[3]) >> 18)
+	data[13] = byte(uint32(a[4]) << 0)
+	data[14] = byte(uint32(a[4]) >> 8)
+	data[15] = byte(uint32(a[4]) >> 16)
+	data[16] = byte(uint32(a[4])>>24) | byte(uint32(a[5])<<2)
+	data[17] = byte(uint32(a[5]) >> 6)
+	data[18] = byte(uint32(a[5]) >> 14)
+	data[19] = byte(uint32(a[5])>>22) | byte(uint32(a[6])<<4)
+	data[20] = byte(uint32(a[6]) >> 4)
+	data[21] = byte(uint32(a[6]) >> 12)
+	data[22] = byte(uint32(a[6])>>20) | byte(uint32(a[7])<<6)
+	data[23] = byte(uint32(a[7]) >> 2)
+	data[24] = byte(uint32(a[7]) >> 10)
+	data[25] = byte(uint32(a[7]) >> 18)
+}
+
+func pack8int32_27(data []byte, a [8]int32) {
+	_ = data[26]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<3)
+	data[4] = byte(uint32(a[1]) >> 5)
+	data[5] = byte(uint32(a[1]) >> 13)
+	data[6] = byte(uint32(a[1])>>21) | byte(uint32(a[2])<<6)
+	data[7] = byte(uint32(a[2]) >> 2)
+	data[8] = byte(uint32(a[2]) >> 10)
+	data[9] = byte(uint32(a[2]) >> 18)
+	data[10] = byte(uint32(a[2])>>26) | byte(uint32(a[3])<<1)
+	data[11] = byte(uint32(a[3]) >> 7)
+	data[12] = byte(uint32(a[3]) >> 15)
+	data[13] = byte(uint32(a[3])>>23) | byte(uint32(a[4])<<4)
+	data[14] = byte(uint32(a[4]) >> 4)
+	data[15] = byte(uint32(a[4]) >> 12)
+	data[16] = byte(uint32(a[4])>>20) | byte(uint32(a[5])<<7)
+	data[17] = byte(uint32(a[5]) >> 1)
+	data[18] = byte(uint32(a[5]) >> 9)
+	data[19] = byte(uint32(a[5]) >> 17)
+	data[20] = byte(uint32(a[5])>>25) | byte(uint32(a[6])<<2)
+	data[21] = byte(uint32(a[6]) >> 6)
+	data[22] = byte(uint32(a[6]) >> 14)
+	data[23] = byte(uint32(a[6])>>22) | byte(uint32(a[7])<<5)
+	data[24] = byte(uint32(a[7]) >> 3)
+	data[25] = byte(uint32(a[7]) >> 11)
+	data[26] = byte(uint32(a[7]) >> 19)
+}
+
+func pack8int32_28(data []byte, a [8]int32) {
+	_ = data[27]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<4)
+	data[4] = byte(uint32(a[1]) >> 4)
+	data[5] = byte(uint32(a[1]) >> 12)
+	data[6] = byte(uint32(a[1]) >> 20)
+	data[7] = byte(uint32(a[2]) << 0)
+	data[8] = byte(uint32(a[2]) >> 8)
+	data[9] = byte(uint32(a[2]) >> 16)
+	data[10] = byte(uint32(a[2])>>24) | byte(uint32(a[3])<<4)
+	data[11] = byte(uint32(a[3]) >> 4)
+	data[12] = byte(uint32(a[3]) >> 12)
+	data[13] = byte(uint32(a[3]) >> 20)
+	data[14] = byte(uint32(a[4]) << 0)
+	data[15] = byte(uint32(a[4]) >> 8)
+	data[16] = byte(uint32(a[4]) >> 16)
+	data[17] = byte(uint32(a[4])>>24) | byte(uint32(a[5])<<4)
+	data[18] = byte(uint32(a[5]) >> 4)
+	data[19] = byte(uint32(a[5]) >> 12)
+	data[20] = byte(uint32(a[5]) >> 20)
+	data[21] = byte(uint32(a[6]) << 0)
+	data[22] = byte(uint32(a[6]) >> 8)
+	data[23] = byte(uint32(a[6]) >> 16)
+	data[24] = byte(uint32(a[6])>>24) | byte(uint32(a[7])<<4)
+	data[25] = byte(uint32(a[7]) >> 4)
+	data[26] = byte(uint32(a[7]) >> 12)
+	data[27] = byte(uint32(a[7]) >> 20)
+}
+
+func pack8int32_29(data []byte, a [8]int32) {
+	_ = data[28]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<5)
+	data[4] = byte(uint32(a[1]) >> 3)
+	data[5] = byte(uint32(a[1]) >> 11)
+	data[6] = byte(uint32(a[1]) >> 19)
+	data[7] = byte(uint32(a[1])>>27) | byte(uint32(a[2])<<2)
+	data[8] = byte(uint32(a[2]) >> 6)
+	data[9] = byte(uint32(a[2]) >> 14)
+	data[10] = byte(uint32(a[2])>>22) | byte(uint32(a[3])<<7)
+	data[11] = byte(uint32(a[3]) >> 1)
+	data[12] = byte(uint32(a[3]) >> 9)
+	data[13] = byte(uint32(a[3]) >> 17)
+	data[14] = byte(uint32(a[3])>>25) | byte(uint32(a[4])<<4)
+	data[15] = byte(uint32(a[4]) >> 4)
+	data[16] = byte(uint32(a[4]) >> 12)
+	data[17] = byte(uint32(a[4]) >> 20)
+	data[18] = byte(uint32(a[4])>>28) | byte(uint32(a[5])<<1)
+	data[19] = byte(uint32(a[5]) >> 7)
+	data[20] = byte(uint32(a[5]) >> 15)
+	data[21] = byte(uint32(a[5])>>23) | byte(uint32(a[6])<<6)
+	data[22] = byte(uint32(a[6]) >> 2)
+	data[23] = byte(uint32(a[6]) >> 10)
+	data[24] = byte(uint32(a[6]) >> 18)
+	data[25] = byte(uint32(a[6])>>26) | byte(uint32(a[7])<<3)
+	data[26] = byte(uint32(a[7]) >> 5)
+	data[27] = byte(uint32(a[7]) >> 13)
+	data[28] = byte(uint32(a[7]) >> 21)
+}
+
+func pack8int32_30(data []byte, a [8]int32) {
+	_ = data[29]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<6)
+	data[4] = byte(uint32(a[1]) >> 2)
+	data[5] = byte(uint32(a[1]) >> 10)
+	data[6] = byte(uint32(a[1]) >> 18)
+	data[7] = byte(uint32(a[1])>>26) | byte(uint32(a[2])<<4)
+	data[8] = byte(uint32(a[2]) >> 4)
+	data[9] = byte(uint32(a[2]) >> 12)
+	data[10] = byte(uint32(a[2]) >> 20)
+	data[11] = byte(uint32(a[2])>>28) | byte(uint32(a[3])<<2)
+	data[12] = byte(uint32(a[3]) >> 6)
+	data[13] = byte(uint32(a[3]) >> 14)
+	data[14] = byte(uint32(a[3]) >> 22)
+	data[15] = byte(uint32(a[4]) << 0)
+	data[16] = byte(uint32(a[4]) >> 8)
+	data[17] = byte(uint32(a[4]) >> 16)
+	data[18] = byte(uint32(a[4])>>24) | byte(uint32(a[5])<<6)
+	data[19] = byte(uint32(a[5]) >> 2)
+	data[20] = byte(uint32(a[5]) >> 10)
+	data[21] = byte(uint32(a[5]) >> 18)
+	data[22] = byte(uint32(a[5])>>26) | byte(uint32(a[6])<<4)
+	data[23] = byte(uint32(a[6]) >> 4)
+	data[24] = byte(uint32(a[6]) >> 12)
+	data[25] = byte(uint32(a[6]) >> 20)
+	data[26] = byte(uint32(a[6])>>28) | byte(uint32(a[7])<<2)
+	data[27] = byte(uint32(a[7]) >> 6)
+	data[28] = byte(uint32(a[7]) >> 14)
+	data[29] = byte(uint32(a[7]) >> 22)
+}
+
+func pack8int32_31(data []byte, a [8]int32) {
+	_ = data[30]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0])>>24) | byte(uint32(a[1])<<7)
+	data[4] = byte(uint32(a[1]) >> 1)
+	data[5] = byte(uint32(a[1]) >> 9)
+	data[6] = byte(uint32(a[1]) >> 17)
+	data[7] = byte(uint32(a[1])>>25) | byte(uint32(a[2])<<6)
+	data[8] = byte(uint32(a[2]) >> 2)
+	data[9] = byte(uint32(a[2]) >> 10)
+	data[10] = byte(uint32(a[2]) >> 18)
+	data[11] = byte(uint32(a[2])>>26) | byte(uint32(a[3])<<5)
+	data[12] = byte(uint32(a[3]) >> 3)
+	data[13] = byte(uint32(a[3]) >> 11)
+	data[14] = byte(uint32(a[3]) >> 19)
+	data[15] = byte(uint32(a[3])>>27) | byte(uint32(a[4])<<4)
+	data[16] = byte(uint32(a[4]) >> 4)
+	data[17] = byte(uint32(a[4]) >> 12)
+	data[18] = byte(uint32(a[4]) >> 20)
+	data[19] = byte(uint32(a[4])>>28) | byte(uint32(a[5])<<3)
+	data[20] = byte(uint32(a[5]) >> 5)
+	data[21] = byte(uint32(a[5]) >> 13)
+	data[22] = byte(uint32(a[5]) >> 21)
+	data[23] = byte(uint32(a[5])>>29) | byte(uint32(a[6])<<2)
+	data[24] = byte(uint32(a[6]) >> 6)
+	data[25] = byte(uint32(a[6]) >> 14)
+	data[26] = byte(uint32(a[6]) >> 22)
+	data[27] = byte(uint32(a[6])>>30) | byte(uint32(a[7])<<1)
+	data[28] = byte(uint32(a[7]) >> 7)
+	data[29] = byte(uint32(a[7]) >> 15)
+	data[30] = byte(uint32(a[7]) >> 23)
+}
+
+func pack8int32_32(data []byte, a [8]int32) {
+	_ = data[31]
+	data[0] = byte(uint32(a[0]) << 0)
+	data[1] = byte(uint32(a[0]) >> 8)
+	data[2] = byte(uint32(a[0]) >> 16)
+	data[3] = byte(uint32(a[0]) >> 24)
+	data[4] = byte(uint32(a[1]) << 0)
+	data[5] = byte(uint32(a[1]) >> 8)
+	data[6] = byte(uint32(a[1]) >> 16)
+	data[7] = byte(uint32(a[1]) >> 24)
+	data[8] = byte(uint32(a[2]) << 0)
+	data[9] = byte(uint32(a[2]) >> 8)
+	data[10] = byte(uint32(a[2]) >> 16)
+	data[11] = byte(uint32(a[2]) >> 24)
+	data[12] = byte(uint32(a[3]) << 0)
+	data[13] = byte(uint32(a[3]) >> 8)
+	data[14] = byte(uint32(a[3]) >> 16)
+	data[15] = byte(uint32(a[3]) >> 24)
+	data[16] = byte(uint32(a[4]) << 0)
+	data[17] = byte(uint32(a[4]) >> 8)
+	data[18] = byte(uint32(a[4]) >> 16)
+	data[19] = byte(uint32(a[4]) >> 24)
+	data[20] = byte(uint32(a[5]) << 0)
+	data[21] = byte(uint32(a[5]) >> 8)
+	data[22] = byte(uint32(a[5]) >> 16)
+	data[23] = byte(uint32(a[5]) >> 24)
+	data[24] = byte(uint32(a[6]) << 0)
+	data[25] = byte(uint32(a[6]) >> 8)
+	data[26] = byte(uint32(a[6]) >> 16)
+	data[27] = byte(uint32(a[6]) >> 24)
+	data[28] = byte(uint32(a[7]) << 0)
+	data[29] = byte(uint32(a[7]) >> 8)
+	data[30] = byte(uint32(a[7]) >> 16)
+	data[31] = byte(uint32(a[7]) >> 24)
+}
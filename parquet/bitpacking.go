@@ -1,5 +1,7 @@
 package parquet
 
+import "fmt"
+
 //go:generate go run bitpacking_gen.go
 
 // Encoding/decoding bit-packed int32 and int64
@@ -152,3 +154,175 @@ var unpack8Int64FuncByWidth = [65]unpack8int64Func{
 	unpack8int64_63,
 	unpack8int64_64,
 }
+
+// pack8int32Func packs 8 values using the same bit order as unpack8int32Func.
+// data must be at least bit width bytes long, values must fit into bit width
+// bits.
+type pack8int32Func func(data []byte, a [8]int32)
+
+func pack8int32_0(data []byte, a [8]int32) {
+}
+
+var pack8Int32FuncByWidth = [33]pack8int32Func{
+	pack8int32_0,
+	pack8int32_1,
+	pack8int32_2,
+	pack8int32_3,
+	pack8int32_4,
+	pack8int32_5,
+	pack8int32_6,
+	pack8int32_7,
+	pack8int32_8,
+	pack8int32_9,
+	pack8int32_10,
+	pack8int32_11,
+	pack8int32_12,
+	pack8int32_13,
+	pack8int32_14,
+	pack8int32_15,
+	pack8int32_16,
+	pack8int32_17,
+	pack8int32_18,
+	pack8int32_19,
+	pack8int32_20,
+	pack8int32_21,
+	pack8int32_22,
+	pack8int32_23,
+	pack8int32_24,
+	pack8int32_25,
+	pack8int32_26,
+	pack8int32_27,
+	pack8int32_28,
+	pack8int32_29,
+	pack8int32_30,
+	pack8int32_31,
+	pack8int32_32,
+}
+
+// pack8int64Func is the same as pack8int32Func for int64 values.
+type pack8int64Func func(data []byte, a [8]int64)
+
+func pack8int64_0(data []byte, a [8]int64) {
+}
+
+var pack8Int64FuncByWidth = [65]pack8int64Func{
+	pack8int64_0,
+	pack8int64_1,
+	pack8int64_2,
+	pack8int64_3,
+	pack8int64_4,
+	pack8int64_5,
+	pack8int64_6,
+	pack8int64_7,
+	pack8int64_8,
+	pack8int64_9,
+	pack8int64_10,
+	pack8int64_11,
+	pack8int64_12,
+	pack8int64_13,
+	pack8int64_14,
+	pack8int64_15,
+	pack8int64_16,
+	pack8int64_17,
+	pack8int64_18,
+	pack8int64_19,
+	pack8int64_20,
+	pack8int64_21,
+	pack8int64_22,
+	pack8int64_23,
+	pack8int64_24,
+	pack8int64_25,
+	pack8int64_26,
+	pack8int64_27,
+	pack8int64_28,
+	pack8int64_29,
+	pack8int64_30,
+	pack8int64_31,
+	pack8int64_32,
+	pack8int64_33,
+	pack8int64_34,
+	pack8int64_35,
+	pack8int64_36,
+	pack8int64_37,
+	pack8int64_38,
+	pack8int64_39,
+	pack8int64_40,
+	pack8int64_41,
+	pack8int64_42,
+	pack8int64_43,
+	pack8int64_44,
+	pack8int64_45,
+	pack8int64_46,
+	pack8int64_47,
+	pack8int64_48,
+	pack8int64_49,
+	pack8int64_50,
+	pack8int64_51,
+	pack8int64_52,
+	pack8int64_53,
+	pack8int64_54,
+	pack8int64_55,
+	pack8int64_56,
+	pack8int64_57,
+	pack8int64_58,
+	pack8int64_59,
+	pack8int64_60,
+	pack8int64_61,
+	pack8int64_62,
+	pack8int64_63,
+	pack8int64_64,
+}
+
+// bitPackedDecoder decodes levels encoded with the deprecated BIT_PACKED
+// encoding. Unlike bit-packed runs of RLE/Bit-Packing Hybrid encoding the
+// values are packed from the MSB of each byte to the LSB and there is no
+// header:
+//
+//	dec value: 0   1   2   3   4   5   6   7
+//	bit value: 000 001 010 011 100 101 110 111
+//	bit label: ABC DEF GHI JKL MNO PQR STU VWX
+//
+// would be encoded like this where spaces mark byte boundaries (3 bytes):
+//
+//	bit value: 00000101 00111001 01110111
+//	bit label: ABCDEFGH IJKLMNOP QRSTUVWX
+type bitPackedDecoder struct {
+	bitWidth int
+
+	data []byte
+	pos  int // position of the next value in bits
+}
+
+func newBitPackedDecoder(w int) *bitPackedDecoder {
+	if w <= 0 || w > 16 {
+		panic(fmt.Sprintf("invalid bitwidth: %d", w))
+	}
+	return &bitPackedDecoder{bitWidth: w}
+}
+
+// bitPackedLevelsSize returns the size in bytes of n values of bit width w
+// encoded with BIT_PACKED encoding.
+func bitPackedLevelsSize(n int, w int) int {
+	return (n*w + 7) / 8
+}
+
+func (d *bitPackedDecoder) init(data []byte) {
+	d.data = data
+	d.pos = 0
+}
+
+func (d *bitPackedDecoder) decodeLevels(dst []uint16) error {
+	for i := 0; i < len(dst); i++ {
+		if d.pos+d.bitWidth > 8*len(d.data) {
+			return errNED
+		}
+		var v uint16
+		for j := 0; j < d.bitWidth; j++ {
+			b := d.data[d.pos>>3] >> (7 - uint(d.pos&7)) & 1
+			v = v<<1 | uint16(b)
+			d.pos++
+		}
+		dst[i] = v
+	}
+	return nil
+}
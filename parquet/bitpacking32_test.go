@@ -1,6 +1,10 @@
 package parquet
 
-import "testing"
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
 
 func TestUnpack8int32(t *testing.T) {
 	for _, test := range unpack8int32Tests {
@@ -11,6 +15,31 @@ func TestUnpack8int32(t *testing.T) {
 	}
 }
 
+func TestPack8int32(t *testing.T) {
+	for _, test := range unpack8int32Tests {
+		data := make([]byte, test.width)
+		pack8Int32FuncByWidth[test.width](data, test.values)
+		if !bytes.Equal(data, test.data) {
+			t.Errorf("pack for width %d: got %v, want %v", test.width, data, test.data)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for w := 1; w <= 32; w++ {
+		for n := 0; n < 100; n++ {
+			var values [8]int32
+			for i := range values {
+				values[i] = int32(r.Uint32() >> uint(32-w))
+			}
+			data := make([]byte, w)
+			pack8Int32FuncByWidth[w](data, values)
+			if got := unpack8Int32FuncByWidth[w](data); got != values {
+				t.Fatalf("width %d: got %v, want %v", w, got, values)
+			}
+		}
+	}
+}
+
 var unpack8int32Tests = []struct {
 	width  int
 	data   []byte
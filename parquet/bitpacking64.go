@@ -833,3 +833,2339 @@ func unpack8int64_64(data []byte) (a [8]int64) {
 	a[7] = int64(uint64((data[56]>>0)&255)<<0 | uint64((data[57]>>0)&255)<<8 | uint64((data[58]>>0)&255)<<16 | uint64((data[59]>>0)&255)<<24 | uint64((data[60]>>0)&255)<<32 | uint64((data[61]>>0)&255)<<40 | uint64((data[62]>>0)&255)<<48 | uint64((data[63]>>0)&255)<<56)
 	return
 }
+
+func pack8int64_1(data []byte, a [8]int64) {
+	_ = data[0]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<1) | byte(uint64(a[2])<<2) | byte(uint64(a[3])<<3) | byte(uint64(a[4])<<4) | byte(uint64(a[5])<<5) | byte(uint64(a[6])<<6) | byte(uint64(a[7])<<7)
+}
+
+func pack8int64_2(data []byte, a [8]int64) {
+	_ = data[1]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<2) | byte(uint64(a[2])<<4) | byte(uint64(a[3])<<6)
+	data[1] = byte(uint64(a[4])<<0) | byte(uint64(a[5])<<2) | byte(uint64(a[6])<<4) | byte(uint64(a[7])<<6)
+}
+
+func pack8int64_3(data []byte, a [8]int64) {
+	_ = data[2]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<3) | byte(uint64(a[2])<<6)
+	data[1] = byte(uint64(a[2])>>2) | byte(uint64(a[3])<<1) | byte(uint64(a[4])<<4) | byte(uint64(a[5])<<7)
+	data[2] = byte(uint64(a[5])>>1) | byte(uint64(a[6])<<2) | byte(uint64(a[7])<<5)
+}
+
+func pack8int64_4(data []byte, a [8]int64) {
+	_ = data[3]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<4)
+	data[1] = byte(uint64(a[2])<<0) | byte(uint64(a[3])<<4)
+	data[2] = byte(uint64(a[4])<<0) | byte(uint64(a[5])<<4)
+	data[3] = byte(uint64(a[6])<<0) | byte(uint64(a[7])<<4)
+}
+
+func pack8int64_5(data []byte, a [8]int64) {
+	_ = data[4]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<5)
+	data[1] = byte(uint64(a[1])>>3) | byte(uint64(a[2])<<2) | byte(uint64(a[3])<<7)
+	data[2] = byte(uint64(a[3])>>1) | byte(uint64(a[4])<<4)
+	data[3] = byte(uint64(a[4])>>4) | byte(uint64(a[5])<<1) | byte(uint64(a[6])<<6)
+	data[4] = byte(uint64(a[6])>>2) | byte(uint64(a[7])<<3)
+}
+
+func pack8int64_6(data []byte, a [8]int64) {
+	_ = data[5]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<6)
+	data[1] = byte(uint64(a[1])>>2) | byte(uint64(a[2])<<4)
+	data[2] = byte(uint64(a[2])>>4) | byte(uint64(a[3])<<2)
+	data[3] = byte(uint64(a[4])<<0) | byte(uint64(a[5])<<6)
+	data[4] = byte(uint64(a[5])>>2) | byte(uint64(a[6])<<4)
+	data[5] = byte(uint64(a[6])>>4) | byte(uint64(a[7])<<2)
+}
+
+func pack8int64_7(data []byte, a [8]int64) {
+	_ = data[6]
+	data[0] = byte(uint64(a[0])<<0) | byte(uint64(a[1])<<7)
+	data[1] = byte(uint64(a[1])>>1) | byte(uint64(a[2])<<6)
+	data[2] = byte(uint64(a[2])>>2) | byte(uint64(a[3])<<5)
+	data[3] = byte(uint64(a[3])>>3) | byte(uint64(a[4])<<4)
+	data[4] = byte(uint64(a[4])>>4) | byte(uint64(a[5])<<3)
+	data[5] = byte(uint64(a[5])>>5) | byte(uint64(a[6])<<2)
+	data[6] = byte(uint64(a[6])>>6) | byte(uint64(a[7])<<1)
+}
+
+func pack8int64_8(data []byte, a [8]int64) {
+	_ = data[7]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[1]) << 0)
+	data[2] = byte(uint64(a[2]) << 0)
+	data[3] = byte(uint64(a[3]) << 0)
+	data[4] = byte(uint64(a[4]) << 0)
+	data[5] = byte(uint64(a[5]) << 0)
+	data[6] = byte(uint64(a[6]) << 0)
+	data[7] = byte(uint64(a[7]) << 0)
+}
+
+func pack8int64_9(data []byte, a [8]int64) {
+	_ = data[8]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<1)
+	data[2] = byte(uint64(a[1])>>7) | byte(uint64(a[2])<<2)
+	data[3] = byte(uint64(a[2])>>6) | byte(uint64(a[3])<<3)
+	data[4] = byte(uint64(a[3])>>5) | byte(uint64(a[4])<<4)
+	data[5] = byte(uint64(a[4])>>4) | byte(uint64(a[5])<<5)
+	data[6] = byte(uint64(a[5])>>3) | byte(uint64(a[6])<<6)
+	data[7] = byte(uint64(a[6])>>2) | byte(uint64(a[7])<<7)
+	data[8] = byte(uint64(a[7]) >> 1)
+}
+
+func pack8int64_10(data []byte, a [8]int64) {
+	_ = data[9]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<2)
+	data[2] = byte(uint64(a[1])>>6) | byte(uint64(a[2])<<4)
+	data[3] = byte(uint64(a[2])>>4) | byte(uint64(a[3])<<6)
+	data[4] = byte(uint64(a[3]) >> 2)
+	data[5] = byte(uint64(a[4]) << 0)
+	data[6] = byte(uint64(a[4])>>8) | byte(uint64(a[5])<<2)
+	data[7] = byte(uint64(a[5])>>6) | byte(uint64(a[6])<<4)
+	data[8] = byte(uint64(a[6])>>4) | byte(uint64(a[7])<<6)
+	data[9] = byte(uint64(a[7]) >> 2)
+}
+
+func pack8int64_11(data []byte, a [8]int64) {
+	_ = data[10]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<3)
+	data[2] = byte(uint64(a[1])>>5) | byte(uint64(a[2])<<6)
+	data[3] = byte(uint64(a[2]) >> 2)
+	data[4] = byte(uint64(a[2])>>10) | byte(uint64(a[3])<<1)
+	data[5] = byte(uint64(a[3])>>7) | byte(uint64(a[4])<<4)
+	data[6] = byte(uint64(a[4])>>4) | byte(uint64(a[5])<<7)
+	data[7] = byte(uint64(a[5]) >> 1)
+	data[8] = byte(uint64(a[5])>>9) | byte(uint64(a[6])<<2)
+	data[9] = byte(uint64(a[6])>>6) | byte(uint64(a[7])<<5)
+	data[10] = byte(uint64(a[7]) >> 3)
+}
+
+func pack8int64_12(data []byte, a [8]int64) {
+	_ = data[11]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<4)
+	data[2] = byte(uint64(a[1]) >> 4)
+	data[3] = byte(uint64(a[2]) << 0)
+	data[4] = byte(uint64(a[2])>>8) | byte(uint64(a[3])<<4)
+	data[5] = byte(uint64(a[3]) >> 4)
+	data[6] = byte(uint64(a[4]) << 0)
+	data[7] = byte(uint64(a[4])>>8) | byte(uint64(a[5])<<4)
+	data[8] = byte(uint64(a[5]) >> 4)
+	data[9] = byte(uint64(a[6]) << 0)
+	data[10] = byte(uint64(a[6])>>8) | byte(uint64(a[7])<<4)
+	data[11] = byte(uint64(a[7]) >> 4)
+}
+
+func pack8int64_13(data []byte, a [8]int64) {
+	_ = data[12]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<5)
+	data[2] = byte(uint64(a[1]) >> 3)
+	data[3] = byte(uint64(a[1])>>11) | byte(uint64(a[2])<<2)
+	data[4] = byte(uint64(a[2])>>6) | byte(uint64(a[3])<<7)
+	data[5] = byte(uint64(a[3]) >> 1)
+	data[6] = byte(uint64(a[3])>>9) | byte(uint64(a[4])<<4)
+	data[7] = byte(uint64(a[4]) >> 4)
+	data[8] = byte(uint64(a[4])>>12) | byte(uint64(a[5])<<1)
+	data[9] = byte(uint64(a[5])>>7) | byte(uint64(a[6])<<6)
+	data[10] = byte(uint64(a[6]) >> 2)
+	data[11] = byte(uint64(a[6])>>10) | byte(uint64(a[7])<<3)
+	data[12] = byte(uint64(a[7]) >> 5)
+}
+
+func pack8int64_14(data []byte, a [8]int64) {
+	_ = data[13]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<6)
+	data[2] = byte(uint64(a[1]) >> 2)
+	data[3] = byte(uint64(a[1])>>10) | byte(uint64(a[2])<<4)
+	data[4] = byte(uint64(a[2]) >> 4)
+	data[5] = byte(uint64(a[2])>>12) | byte(uint64(a[3])<<2)
+	data[6] = byte(uint64(a[3]) >> 6)
+	data[7] = byte(uint64(a[4]) << 0)
+	data[8] = byte(uint64(a[4])>>8) | byte(uint64(a[5])<<6)
+	data[9] = byte(uint64(a[5]) >> 2)
+	data[10] = byte(uint64(a[5])>>10) | byte(uint64(a[6])<<4)
+	data[11] = byte(uint64(a[6]) >> 4)
+	data[12] = byte(uint64(a[6])>>12) | byte(uint64(a[7])<<2)
+	data[13] = byte(uint64(a[7]) >> 6)
+}
+
+func pack8int64_15(data []byte, a [8]int64) {
+	_ = data[14]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0])>>8) | byte(uint64(a[1])<<7)
+	data[2] = byte(uint64(a[1]) >> 1)
+	data[3] = byte(uint64(a[1])>>9) | byte(uint64(a[2])<<6)
+	data[4] = byte(uint64(a[2]) >> 2)
+	data[5] = byte(uint64(a[2])>>10) | byte(uint64(a[3])<<5)
+	data[6] = byte(uint64(a[3]) >> 3)
+	data[7] = byte(uint64(a[3])>>11) | byte(uint64(a[4])<<4)
+	data[8] = byte(uint64(a[4]) >> 4)
+	data[9] = byte(uint64(a[4])>>12) | byte(uint64(a[5])<<3)
+	data[10] = byte(uint64(a[5]) >> 5)
+	data[11] = byte(uint64(a[5])>>13) | byte(uint64(a[6])<<2)
+	data[12] = byte(uint64(a[6]) >> 6)
+	data[13] = byte(uint64(a[6])>>14) | byte(uint64(a[7])<<1)
+	data[14] = byte(uint64(a[7]) >> 7)
+}
+
+func pack8int64_16(data []byte, a [8]int64) {
+	_ = data[15]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[1]) << 0)
+	data[3] = byte(uint64(a[1]) >> 8)
+	data[4] = byte(uint64(a[2]) << 0)
+	data[5] = byte(uint64(a[2]) >> 8)
+	data[6] = byte(uint64(a[3]) << 0)
+	data[7] = byte(uint64(a[3]) >> 8)
+	data[8] = byte(uint64(a[4]) << 0)
+	data[9] = byte(uint64(a[4]) >> 8)
+	data[10] = byte(uint64(a[5]) << 0)
+	data[11] = byte(uint64(a[5]) >> 8)
+	data[12] = byte(uint64(a[6]) << 0)
+	data[13] = byte(uint64(a[6]) >> 8)
+	data[14] = byte(uint64(a[7]) << 0)
+	data[15] = byte(uint64(a[7]) >> 8)
+}
+
+func pack8int64_17(data []byte, a [8]int64) {
+	_ = data[16]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<1)
+	data[3] = byte(uint64(a[1]) >> 7)
+	data[4] = byte(uint64(a[1])>>15) | byte(uint64(a[2])<<2)
+	data[5] = byte(uint64(a[2]) >> 6)
+	data[6] = byte(uint64(a[2])>>14) | byte(uint64(a[3])<<3)
+	data[7] = byte(uint64(a[3]) >> 5)
+	data[8] = byte(uint64(a[3])>>13) | byte(uint64(a[4])<<4)
+	data[9] = byte(uint64(a[4]) >> 4)
+	data[10] = byte(uint64(a[4])>>12) | byte(uint64(a[5])<<5)
+	data[11] = byte(uint64(a[5]) >> 3)
+	data[12] = byte(uint64(a[5])>>11) | byte(uint64(a[6])<<6)
+	data[13] = byte(uint64(a[6]) >> 2)
+	data[14] = byte(uint64(a[6])>>10) | byte(uint64(a[7])<<7)
+	data[15] = byte(uint64(a[7]) >> 1)
+	data[16] = byte(uint64(a[7]) >> 9)
+}
+
+func pack8int64_18(data []byte, a [8]int64) {
+	_ = data[17]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<2)
+	data[3] = byte(uint64(a[1]) >> 6)
+	data[4] = byte(uint64(a[1])>>14) | byte(uint64(a[2])<<4)
+	data[5] = byte(uint64(a[2]) >> 4)
+	data[6] = byte(uint64(a[2])>>12) | byte(uint64(a[3])<<6)
+	data[7] = byte(uint64(a[3]) >> 2)
+	data[8] = byte(uint64(a[3]) >> 10)
+	data[9] = byte(uint64(a[4]) << 0)
+	data[10] = byte(uint64(a[4]) >> 8)
+	data[11] = byte(uint64(a[4])>>16) | byte(uint64(a[5])<<2)
+	data[12] = byte(uint64(a[5]) >> 6)
+	data[13] = byte(uint64(a[5])>>14) | byte(uint64(a[6])<<4)
+	data[14] = byte(uint64(a[6]) >> 4)
+	data[15] = byte(uint64(a[6])>>12) | byte(uint64(a[7])<<6)
+	data[16] = byte(uint64(a[7]) >> 2)
+	data[17] = byte(uint64(a[7]) >> 10)
+}
+
+func pack8int64_19(data []byte, a [8]int64) {
+	_ = data[18]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<3)
+	data[3] = byte(uint64(a[1]) >> 5)
+	data[4] = byte(uint64(a[1])>>13) | byte(uint64(a[2])<<6)
+	data[5] = byte(uint64(a[2]) >> 2)
+	data[6] = byte(uint64(a[2]) >> 10)
+	data[7] = byte(uint64(a[2])>>18) | byte(uint64(a[3])<<1)
+	data[8] = byte(uint64(a[3]) >> 7)
+	data[9] = byte(uint64(a[3])>>15) | byte(uint64(a[4])<<4)
+	data[10] = byte(uint64(a[4]) >> 4)
+	data[11] = byte(uint64(a[4])>>12) | byte(uint64(a[5])<<7)
+	data[12] = byte(uint64(a[5]) >> 1)
+	data[13] = byte(uint64(a[5]) >> 9)
+	data[14] = byte(uint64(a[5])>>17) | byte(uint64(a[6])<<2)
+	data[15] = byte(uint64(a[6]) >> 6)
+	data[16] = byte(uint64(a[6])>>14) | byte(uint64(a[7])<<5)
+	data[17] = byte(uint64(a[7]) >> 3)
+	data[18] = byte(uint64(a[7]) >> 11)
+}
+
+func pack8int64_20(data []byte, a [8]int64) {
+	_ = data[19]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<4)
+	data[3] = byte(uint64(a[1]) >> 4)
+	data[4] = byte(uint64(a[1]) >> 12)
+	data[5] = byte(uint64(a[2]) << 0)
+	data[6] = byte(uint64(a[2]) >> 8)
+	data[7] = byte(uint64(a[2])>>16) | byte(uint64(a[3])<<4)
+	data[8] = byte(uint64(a[3]) >> 4)
+	data[9] = byte(uint64(a[3]) >> 12)
+	data[10] = byte(uint64(a[4]) << 0)
+	data[11] = byte(uint64(a[4]) >> 8)
+	data[12] = byte(uint64(a[4])>>16) | byte(uint64(a[5])<<4)
+	data[13] = byte(uint64(a[5]) >> 4)
+	data[14] = byte(uint64(a[5]) >> 12)
+	data[15] = byte(uint64(a[6]) << 0)
+	data[16] = byte(uint64(a[6]) >> 8)
+	data[17] = byte(uint64(a[6])>>16) | byte(uint64(a[7])<<4)
+	data[18] = byte(uint64(a[7]) >> 4)
+	data[19] = byte(uint64(a[7]) >> 12)
+}
+
+func pack8int64_21(data []byte, a [8]int64) {
+	_ = data[20]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<5)
+	data[3] = byte(uint64(a[1]) >> 3)
+	data[4] = byte(uint64(a[1]) >> 11)
+	data[5] = byte(uint64(a[1])>>19) | byte(uint64(a[2])<<2)
+	data[6] = byte(uint64(a[2]) >> 6)
+	data[7] = byte(uint64(a[2])>>14) | byte(uint64(a[3])<<7)
+	data[8] = byte(uint64(a[3]) >> 1)
+	data[9] = byte(uint64(a[3]) >> 9)
+	data[10] = byte(uint64(a[3])>>17) | byte(uint64(a[4])<<4)
+	data[11] = byte(uint64(a[4]) >> 4)
+	data[12] = byte(uint64(a[4]) >> 12)
+	data[13] = byte(uint64(a[4])>>20) | byte(uint64(a[5])<<1)
+	data[14] = byte(uint64(a[5]) >> 7)
+	data[15] = byte(uint64(a[5])>>15) | byte(uint64(a[6])<<6)
+	data[16] = byte(uint64(a[6]) >> 2)
+	data[17] = byte(uint64(a[6]) >> 10)
+	data[18] = byte(uint64(a[6])>>18) | byte(uint64(a[7])<<3)
+	data[19] = byte(uint64(a[7]) >> 5)
+	data[20] = byte(uint64(a[7]) >> 13)
+}
+
+func pack8int64_22(data []byte, a [8]int64) {
+	_ = data[21]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<6)
+	data[3] = byte(uint64(a[1]) >> 2)
+	data[4] = byte(uint64(a[1]) >> 10)
+	data[5] = byte(uint64(a[1])>>18) | byte(uint64(a[2])<<4)
+	data[6] = byte(uint64(a[2]) >> 4)
+	data[7] = byte(uint64(a[2]) >> 12)
+	data[8] = byte(uint64(a[2])>>20) | byte(uint64(a[3])<<2)
+	data[9] = byte(uint64(a[3]) >> 6)
+	data[10] = byte(uint64(a[3]) >> 14)
+	data[11] = byte(uint64(a[4]) << 0)
+	data[12] = byte(uint64(a[4]) >> 8)
+	data[13] = byte(uint64(a[4])>>16) | byte(uint64(a[5])<<6)
+	data[14] = byte(uint64(a[5]) >> 2)
+	data[15] = byte(uint64(a[5]) >> 10)
+	data[16] = byte(uint64(a[5])>>18) | byte(uint64(a[6])<<4)
+	data[17] = byte(uint64(a[6]) >> 4)
+	data[18] = byte(uint64(a[6]) >> 12)
+	data[19] = byte(uint64(a[6])>>20) | byte(uint64(a[7])<<2)
+	data[20] = byte(uint64(a[7]) >> 6)
+	data[21] = byte(uint64(a[7]) >> 14)
+}
+
+func pack8int64_23(data []byte, a [8]int64) {
+	_ = data[22]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0])>>16) | byte(uint64(a[1])<<7)
+	data[3] = byte(uint64(a[1]) >> 1)
+	data[4] = byte(uint64(a[1]) >> 9)
+	data[5] = byte(uint64(a[1])>>17) | byte(uint64(a[2])<<6)
+	data[6] = byte(uint64(a[2]) >> 2)
+	data[7] = byte(uint64(a[2]) >> 10)
+	data[8] = byte(uint64(a[2])>>18) | byte(uint64(a[3])<<5)
+	data[9] = byte(uint64(a[3]) >> 3)
+	data[10] = byte(uint64(a[3]) >> 11)
+	data[11] = byte(uint64(a[3])>>19) | byte(uint64(a[4])<<4)
+	data[12] = byte(uint64(a[4]) >> 4)
+	data[13] = byte(uint64(a[4]) >> 12)
+	data[14] = byte(uint64(a[4])>>20) | byte(uint64(a[5])<<3)
+	data[15] = byte(uint64(a[5]) >> 5)
+	data[16] = byte(uint64(a[5]) >> 13)
+	data[17] = byte(uint64(a[5])>>21) | byte(uint64(a[6])<<2)
+	data[18] = byte(uint64(a[6]) >> 6)
+	data[19] = byte(uint64(a[6]) >> 14)
+	data[20] = byte(uint64(a[6])>>22) | byte(uint64(a[7])<<1)
+	data[21] = byte(uint64(a[7]) >> 7)
+	data[22] = byte(uint64(a[7]) >> 15)
+}
+
+func pack8int64_24(data []byte, a [8]int64) {
+	_ = data[23]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[1]) << 0)
+	data[4] = byte(uint64(a[1]) >> 8)
+	data[5] = byte(uint64(a[1]) >> 16)
+	data[6] = byte(uint64(a[2]) << 0)
+	data[7] = byte(uint64(a[2]) >> 8)
+	data[8] = byte(uint64(a[2]) >> 16)
+	data[9] = byte(uint64(a[3]) << 0)
+	data[10] = byte(uint64(a[3]) >> 8)
+	data[11] = byte(uint64(a[3]) >> 16)
+	data[12] = byte(uint64(a[4]) << 0)
+	data[13] = byte(uint64(a[4]) >> 8)
+	data[14] = byte(uint64(a[4]) >> 16)
+	data[15] = byte(uint64(a[5]) << 0)
+	data[16] = byte(uint64(a[5]) >> 8)
+	data[17] = byte(uint64(a[5]) >> 16)
+	data[18] = byte(uint64(a[6]) << 0)
+	data[19] = byte(uint64(a[6]) >> 8)
+	data[20] = byte(uint64(a[6]) >> 16)
+	data[21] = byte(uint64(a[7]) << 0)
+	data[22] = byte(uint64(a[7]) >> 8)
+	data[23] = byte(uint64(a[7]) >> 16)
+}
+
+func pack8int64_25(data []byte, a [8]int64) {
+	_ = data[24]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<1)
+	data[4] = byte(uint64(a[1]) >> 7)
+	data[5] = byte(uint64(a[1]) >> 15)
+	data[6] = byte(uint64(a[1])>>23) | byte(uint64(a[2])<<2)
+	data[7] = byte(uint64(a[2]) >> 6)
+	data[8] = byte(uint64(a[2]) >> 14)
+	data[9] = byte(uint64(a[2])>>22) | byte(uint64(a[3])<<3)
+	data[10] = byte(uint64(a[3]) >> 5)
+	data[11] = byte(uint64(a[3]) >> 13)
+	data[12] = byte(uint64(a[3])>>21) | byte(uint64(a[4])<<4)
+	data[13] = byte(uint64(a[4]) >> 4)
+	data[14] = byte(uint64(a[4]) >> 12)
+	data[15] = byte(uint64(a[4])>>20) | byte(uint64(a[5])<<5)
+	data[16] = byte(uint64(a[5]) >> 3)
+	data[17] = byte(uint64(a[5]) >> 11)
+	data[18] = byte(uint64(a[5])>>19) | byte(uint64(a[6])<<6)
+	data[19] = byte(uint64(a[6]) >> 2)
+	data[20] = byte(uint64(a[6]) >> 10)
+	data[21] = byte(uint64(a[6])>>18) | byte(uint64(a[7])<<7)
+	data[22] = byte(uint64(a[7]) >> 1)
+	data[23] = byte(uint64(a[7]) >> 9)
+	data[24] = byte(uint64(a[7]) >> 17)
+}
+
+func pack8int64_26(data []byte, a [8]int64) {
+	_ = data[25]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<2)
+	data[4] = byte(uint64(a[1]) >> 6)
+	data[5] = byte(uint64(a[1]) >> 14)
+	data[6] = byte(uint64(a[1])>>22) | byte(uint64(a[2])<<4)
+	data[7] = byte(uint64(a[2]) >> 4)
+	data[8] = byte(uint64(a[2]) >> 12)
+	data[9] = byte(uint64(a[2])>>20) | byte(uint64(a[3])<<6)
+	data[10] = byte(uint64(a[3]) >> 2)
+	data[11] = byte(uint64(a[3]) >> 10)
+	data[12] = byte(uint64(a[3]) >> 18)
+	data[13] = byte(uint64(a[4]) << 0)
+	data[14] = byte(uint64(a[4]) >> 8)
+	data[15] = byte(uint64(a[4]) >> 16)
+	data[16] = byte(uint64(a[4])>>24) | byte(uint64(a[5])<<2)
+	data[17] = byte(uint64(a[5]) >> 6)
+	data[18] = byte(uint64(a[5]) >> 14)
+	data[19] = byte(uint64(a[5])>>22) | byte(uint64(a[6])<<4)
+	data[20] = byte(uint64(a[6]) >> 4)
+	data[21] = byte(uint64(a[6]) >> 12)
+	data[22] = byte(uint64(a[6])>>20) | byte(uint64(a[7])<<6)
+	data[23] = byte(uint64(a[7]) >> 2)
+	data[24] = byte(uint64(a[7]) >> 10)
+	data[25] = byte(uint64(a[7]) >> 18)
+}
+
+func pack8int64_27(data []byte, a [8]int64) {
+	_ = data[26]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<3)
+	data[4] = byte(uint64(a[1]) >> 5)
+	data[5] = byte(uint64(a[1]) >> 13)
+	data[6] = byte(uint64(a[1])>>21) | byte(uint64(a[2])<<6)
+	data[7] = byte(uint64(a[2]) >> 2)
+	data[8] = byte(uint64(a[2]) >> 10)
+	data[9] = byte(uint64(a[2]) >> 18)
+	data[10] = byte(uint64(a[2])>>26) | byte(uint64(a[3])<<1)
+	data[11] = byte(uint64(a[3]) >> 7)
+	data[12] = byte(uint64(a[3]) >> 15)
+	data[13] = byte(uint64(a[3])>>23) | byte(uint64(a[4])<<4)
+	data[14] = byte(uint64(a[4]) >> 4)
+	data[15] = byte(uint64(a[4]) >> 12)
+	data[16] = byte(uint64(a[4])>>20) | byte(uint64(a[5])<<7)
+	data[17] = byte(uint64(a[5]) >> 1)
+	data[18] = byte(uint64(a[5]) >> 9)
+	data[19] = byte(uint64(a[5]) >> 17)
+	data[20] = byte(uint64(a[5])>>25) | byte(uint64(a[6])<<2)
+	data[21] = byte(uint64(a[6]) >> 6)
+	data[22] = byte(uint64(a[6]) >> 14)
+	data[23] = byte(uint64(a[6])>>22) | byte(uint64(a[7])<<5)
+	data[24] = byte(uint64(a[7]) >> 3)
+	data[25] = byte(uint64(a[7]) >> 11)
+	data[26] = byte(uint64(a[7]) >> 19)
+}
+
+func pack8int64_28(data []byte, a [8]int64) {
+	_ = data[27]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<4)
+	data[4] = byte(uint64(a[1]) >> 4)
+	data[5] = byte(uint64(a[1]) >> 12)
+	data[6] = byte(uint64(a[1]) >> 20)
+	data[7] = byte(uint64(a[2]) << 0)
+	data[8] = byte(uint64(a[2]) >> 8)
+	data[9] = byte(uint64(a[2]) >> 16)
+	data[10] = byte(uint64(a[2])>>24) | byte(uint64(a[3])<<4)
+	data[11] = byte(uint64(a[3]) >> 4)
+	data[12] = byte(uint64(a[3]) >> 12)
+	data[13] = byte(uint64(a[3]) >> 20)
+	data[14] = byte(uint64(a[4]) << 0)
+	data[15] = byte(uint64(a[4]) >> 8)
+	data[16] = byte(uint64(a[4]) >> 16)
+	data[17] = byte(uint64(a[4])>>24) | byte(uint64(a[5])<<4)
+	data[18] = byte(uint64(a[5]) >> 4)
+	data[19] = byte(uint64(a[5]) >> 12)
+	data[20] = byte(uint64(a[5]) >> 20)
+	data[21] = byte(uint64(a[6]) << 0)
+	data[22] = byte(uint64(a[6]) >> 8)
+	data[23] = byte(uint64(a[6]) >> 16)
+	data[24] = byte(uint64(a[6])>>24) | byte(uint64(a[7])<<4)
+	data[25] = byte(uint64(a[7]) >> 4)
+	data[26] = byte(uint64(a[7]) >> 12)
+	data[27] = byte(uint64(a[7]) >> 20)
+}
+
+func pack8int64_29(data []byte, a [8]int64) {
+	_ = data[28]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<5)
+	data[4] = byte(uint64(a[1]) >> 3)
+	data[5] = byte(uint64(a[1]) >> 11)
+	data[6] = byte(uint64(a[1]) >> 19)
+	data[7] = byte(uint64(a[1])>>27) | byte(uint64(a[2])<<2)
+	data[8] = byte(uint64(a[2]) >> 6)
+	data[9] = byte(uint64(a[2]) >> 14)
+	data[10] = byte(uint64(a[2])>>22) | byte(uint64(a[3])<<7)
+	data[11] = byte(uint64(a[3]) >> 1)
+	data[12] = byte(uint64(a[3]) >> 9)
+	data[13] = byte(uint64(a[3]) >> 17)
+	data[14] = byte(uint64(a[3])>>25) | byte(uint64(a[4])<<4)
+	data[15] = byte(uint64(a[4]) >> 4)
+	data[16] = byte(uint64(a[4]) >> 12)
+	data[17] = byte(uint64(a[4]) >> 20)
+	data[18] = byte(uint64(a[4])>>28) | byte(uint64(a[5])<<1)
+	data[19] = byte(uint64(a[5]) >> 7)
+	data[20] = byte(uint64(a[5]) >> 15)
+	data[21] = byte(uint64(a[5])>>23) | byte(uint64(a[6])<<6)
+	data[22] = byte(uint64(a[6]) >> 2)
+	data[23] = byte(uint64(a[6]) >> 10)
+	data[24] = byte(uint64(a[6]) >> 18)
+	data[25] = byte(uint64(a[6])>>26) | byte(uint64(a[7])<<3)
+	data[26] = byte(uint64(a[7]) >> 5)
+	data[27] = byte(uint64(a[7]) >> 13)
+	data[28] = byte(uint64(a[7]) >> 21)
+}
+
+func pack8int64_30(data []byte, a [8]int64) {
+	_ = data[29]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<6)
+	data[4] = byte(uint64(a[1]) >> 2)
+	data[5] = byte(uint64(a[1]) >> 10)
+	data[6] = byte(uint64(a[1]) >> 18)
+	data[7] = byte(uint64(a[1])>>26) | byte(uint64(a[2])<<4)
+	data[8] = byte(uint64(a[2]) >> 4)
+	data[9] = byte(uint64(a[2]) >> 12)
+	data[10] = byte(uint64(a[2]) >> 20)
+	data[11] = byte(uint64(a[2])>>28) | byte(uint64(a[3])<<2)
+	data[12] = byte(uint64(a[3]) >> 6)
+	data[13] = byte(uint64(a[3]) >> 14)
+	data[14] = byte(uint64(a[3]) >> 22)
+	data[15] = byte(uint64(a[4]) << 0)
+	data[16] = byte(uint64(a[4]) >> 8)
+	data[17] = byte(uint64(a[4]) >> 16)
+	data[18] = byte(uint64(a[4])>>24) | byte(uint64(a[5])<<6)
+	data[19] = byte(uint64(a[5]) >> 2)
+	data[20] = byte(uint64(a[5]) >> 10)
+	data[21] = byte(uint64(a[5]) >> 18)
+	data[22] = byte(uint64(a[5])>>26) | byte(uint64(a[6])<<4)
+	data[23] = byte(uint64(a[6]) >> 4)
+	data[24] = byte(uint64(a[6]) >> 12)
+	data[25] = byte(uint64(a[6]) >> 20)
+	data[26] = byte(uint64(a[6])>>28) | byte(uint64(a[7])<<2)
+	data[27] = byte(uint64(a[7]) >> 6)
+	data[28] = byte(uint64(a[7]) >> 14)
+	data[29] = byte(uint64(a[7]) >> 22)
+}
+
+func pack8int64_31(data []byte, a [8]int64) {
+	_ = data[30]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0])>>24) | byte(uint64(a[1])<<7)
+	data[4] = byte(uint64(a[1]) >> 1)
+	data[5] = byte(uint64(a[1]) >> 9)
+	data[6] = byte(uint64(a[1]) >> 17)
+	data[7] = byte(uint64(a[1])>>25) | byte(uint64(a[2])<<6)
+	data[8] = byte(uint64(a[2]) >> 2)
+	data[9] = byte(uint64(a[2]) >> 10)
+	data[10] = byte(uint64(a[2]) >> 18)
+	data[11] = byte(uint64(a[2])>>26) | byte(uint64(a[3])<<5)
+	data[12] = byte(uint64(a[3]) >> 3)
+	data[13] = byte(uint64(a[3]) >> 11)
+	data[14] = byte(uint64(a[3]) >> 19)
+	data[15] = byte(uint64(a[3])>>27) | byte(uint64(a[4])<<4)
+	data[16] = byte(uint64(a[4]) >> 4)
+	data[17] = byte(uint64(a[4]) >> 12)
+	data[18] = byte(uint64(a[4]) >> 20)
+	data[19] = byte(uint64(a[4])>>28) | byte(uint64(a[5])<<3)
+	data[20] = byte(uint64(a[5]) >> 5)
+	data[21] = byte(uint64(a[5]) >> 13)
+	data[22] = byte(uint64(a[5]) >> 21)
+	data[23] = byte(uint64(a[5])>>29) | byte(uint64(a[6])<<2)
+	data[24] = byte(uint64(a[6]) >> 6)
+	data[25] = byte(uint64(a[6]) >> 14)
+	data[26] = byte(uint64(a[6]) >> 22)
+	data[27] = byte(uint64(a[6])>>30) | byte(uint64(a[7])<<1)
+	data[28] = byte(uint64(a[7]) >> 7)
+	data[29] = byte(uint64(a[7]) >> 15)
+	data[30] = byte(uint64(a[7]) >> 23)
+}
+
+func pack8int64_32(data []byte, a [8]int64) {
+	_ = data[31]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[1]) << 0)
+	data[5] = byte(uint64(a[1]) >> 8)
+	data[6] = byte(uint64(a[1]) >> 16)
+	data[7] = byte(uint64(a[1]) >> 24)
+	data[8] = byte(uint64(a[2]) << 0)
+	data[9] = byte(uint64(a[2]) >> 8)
+	data[10] = byte(uint64(a[2]) >> 16)
+	data[11] = byte(uint64(a[2]) >> 24)
+	data[12] = byte(uint64(a[3]) << 0)
+	data[13] = byte(uint64(a[3]) >> 8)
+	data[14] = byte(uint64(a[3]) >> 16)
+	data[15] = byte(uint64(a[3]) >> 24)
+	data[16] = byte(uint64(a[4]) << 0)
+	data[17] = byte(uint64(a[4]) >> 8)
+	data[18] = byte(uint64(a[4]) >> 16)
+	data[19] = byte(uint64(a[4]) >> 24)
+	data[20] = byte(uint64(a[5]) << 0)
+	data[21] = byte(uint64(a[5]) >> 8)
+	data[22] = byte(uint64(a[5]) >> 16)
+	data[23] = byte(uint64(a[5]) >> 24)
+	data[24] = byte(uint64(a[6]) << 0)
+	data[25] = byte(uint64(a[6]) >> 8)
+	data[26] = byte(uint64(a[6]) >> 16)
+	data[27] = byte(uint64(a[6]) >> 24)
+	data[28] = byte(uint64(a[7]) << 0)
+	data[29] = byte(uint64(a[7]) >> 8)
+	data[30] = byte(uint64(a[7]) >> 16)
+	data[31] = byte(uint64(a[7]) >> 24)
+}
+
+func pack8int64_33(data []byte, a [8]int64) {
+	_ = data[32]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<1)
+	data[5] = byte(uint64(a[1]) >> 7)
+	data[6] = byte(uint64(a[1]) >> 15)
+	data[7] = byte(uint64(a[1]) >> 23)
+	data[8] = byte(uint64(a[1])>>31) | byte(uint64(a[2])<<2)
+	data[9] = byte(uint64(a[2]) >> 6)
+	data[10] = byte(uint64(a[2]) >> 14)
+	data[11] = byte(uint64(a[2]) >> 22)
+	data[12] = byte(uint64(a[2])>>30) | byte(uint64(a[3])<<3)
+	data[13] = byte(uint64(a[3]) >> 5)
+	data[14] = byte(uint64(a[3]) >> 13)
+	data[15] = byte(uint64(a[3]) >> 21)
+	data[16] = byte(uint64(a[3])>>29) | byte(uint64(a[4])<<4)
+	data[17] = byte(uint64(a[4]) >> 4)
+	data[18] = byte(uint64(a[4]) >> 12)
+	data[19] = byte(uint64(a[4]) >> 20)
+	data[20] = byte(uint64(a[4])>>28) | byte(uint64(a[5])<<5)
+	data[21] = byte(uint64(a[5]) >> 3)
+	data[22] = byte(uint64(a[5]) >> 11)
+	data[23] = byte(uint64(a[5]) >> 19)
+	data[24] = byte(uint64(a[5])>>27) | byte(uint64(a[6])<<6)
+	data[25] = byte(uint64(a[6]) >> 2)
+	data[26] = byte(uint64(a[6]) >> 10)
+	data[27] = byte(uint64(a[6]) >> 18)
+	data[28] = byte(uint64(a[6])>>26) | byte(uint64(a[7])<<7)
+	data[29] = byte(uint64(a[7]) >> 1)
+	data[30] = byte(uint64(a[7]) >> 9)
+	data[31] = byte(uint64(a[7]) >> 17)
+	data[32] = byte(uint64(a[7]) >> 25)
+}
+
+func pack8int64_34(data []byte, a [8]int64) {
+	_ = data[33]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<2)
+	data[5] = byte(uint64(a[1]) >> 6)
+	data[6] = byte(uint64(a[1]) >> 14)
+	data[7] = byte(uint64(a[1]) >> 22)
+	data[8] = byte(uint64(a[1])>>30) | byte(uint64(a[2])<<4)
+	data[9] = byte(uint64(a[2]) >> 4)
+	data[10] = byte(uint64(a[2]) >> 12)
+	data[11] = byte(uint64(a[2]) >> 20)
+	data[12] = byte(uint64(a[2])>>28) | byte(uint64(a[3])<<6)
+	data[13] = byte(uint64(a[3]) >> 2)
+	data[14] = byte(uint64(a[3]) >> 10)
+	data[15] = byte(uint64(a[3]) >> 18)
+	data[16] = byte(uint64(a[3]) >> 26)
+	data[17] = byte(uint64(a[4]) << 0)
+	data[18] = byte(uint64(a[4]) >> 8)
+	data[19] = byte(uint64(a[4]) >> 16)
+	data[20] = byte(uint64(a[4]) >> 24)
+	data[21] = byte(uint64(a[4])>>32) | byte(uint64(a[5])<<2)
+	data[22] = byte(uint64(a[5]) >> 6)
+	data[23] = byte(uint64(a[5]) >> 14)
+	data[24] = byte(uint64(a[5]) >> 22)
+	data[25] = byte(uint64(a[5])>>30) | byte(uint64(a[6])<<4)
+	data[26] = byte(uint64(a[6]) >> 4)
+	data[27] = byte(uint64(a[6]) >> 12)
+	data[28] = byte(uint64(a[6]) >> 20)
+	data[29] = byte(uint64(a[6])>>28) | byte(uint64(a[7])<<6)
+	data[30] = byte(uint64(a[7]) >> 2)
+	data[31] = byte(uint64(a[7]) >> 10)
+	data[32] = byte(uint64(a[7]) >> 18)
+	data[33] = byte(uint64(a[7]) >> 26)
+}
+
+func pack8int64_35(data []byte, a [8]int64) {
+	_ = data[34]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<3)
+	data[5] = byte(uint64(a[1]) >> 5)
+	data[6] = byte(uint64(a[1]) >> 13)
+	data[7] = byte(uint64(a[1]) >> 21)
+	data[8] = byte(uint64(a[1])>>29) | byte(uint64(a[2])<<6)
+	data[9] = byte(uint64(a[2]) >> 2)
+	data[10] = byte(uint64(a[2]) >> 10)
+	data[11] = byte(uint64(a[2]) >> 18)
+	data[12] = byte(uint64(a[2]) >> 26)
+	data[13] = byte(uint64(a[2])>>34) | byte(uint64(a[3])<<1)
+	data[14] = byte(uint64(a[3]) >> 7)
+	data[15] = byte(uint64(a[3]) >> 15)
+	data[16] = byte(uint64(a[3]) >> 23)
+	data[17] = byte(uint64(a[3])>>31) | byte(uint64(a[4])<<4)
+	data[18] = byte(uint64(a[4]) >> 4)
+	data[19] = byte(uint64(a[4]) >> 12)
+	data[20] = byte(uint64(a[4]) >> 20)
+	data[21] = byte(uint64(a[4])>>28) | byte(uint64(a[5])<<7)
+	data[22] = byte(uint64(a[5]) >> 1)
+	data[23] = byte(uint64(a[5]) >> 9)
+	data[24] = byte(uint64(a[5]) >> 17)
+	data[25] = byte(uint64(a[5]) >> 25)
+	data[26] = byte(uint64(a[5])>>33) | byte(uint64(a[6])<<2)
+	data[27] = byte(uint64(a[6]) >> 6)
+	data[28] = byte(uint64(a[6]) >> 14)
+	data[29] = byte(uint64(a[6]) >> 22)
+	data[30] = byte(uint64(a[6])>>30) | byte(uint64(a[7])<<5)
+	data[31] = byte(uint64(a[7]) >> 3)
+	data[32] = byte(uint64(a[7]) >> 11)
+	data[33] = byte(uint64(a[7]) >> 19)
+	data[34] = byte(uint64(a[7]) >> 27)
+}
+
+func pack8int64_36(data []byte, a [8]int64) {
+	_ = data[35]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<4)
+	data[5] = byte(uint64(a[1]) >> 4)
+	data[6] = byte(uint64(a[1]) >> 12)
+	data[7] = byte(uint64(a[1]) >> 20)
+	data[8] = byte(uint64(a[1]) >> 28)
+	data[9] = byte(uint64(a[2]) << 0)
+	data[10] = byte(uint64(a[2]) >> 8)
+	data[11] = byte(uint64(a[2]) >> 16)
+	data[12] = byte(uint64(a[2]) >> 24)
+	data[13] = byte(uint64(a[2])>>32) | byte(uint64(a[3])<<4)
+	data[14] = byte(uint64(a[3]) >> 4)
+	data[15] = byte(uint64(a[3]) >> 12)
+	data[16] = byte(uint64(a[3]) >> 20)
+	data[17] = byte(uint64(a[3]) >> 28)
+	data[18] = byte(uint64(a[4]) << 0)
+	data[19] = byte(uint64(a[4]) >> 8)
+	data[20] = byte(uint64(a[4]) >> 16)
+	data[21] = byte(uint64(a[4]) >> 24)
+	data[22] = byte(uint64(a[4])>>32) | byte(uint64(a[5])<<4)
+	data[23] = byte(uint64(a[5]) >> 4)
+	data[24] = byte(uint64(a[5]) >> 12)
+	data[25] = byte(uint64(a[5]) >> 20)
+	data[26] = byte(uint64(a[5]) >> 28)
+	data[27] = byte(uint64(a[6]) << 0)
+	data[28] = byte(uint64(a[6]) >> 8)
+	data[29] = byte(uint64(a[6]) >> 16)
+	data[30] = byte(uint64(a[6]) >> 24)
+	data[31] = byte(uint64(a[6])>>32) | byte(uint64(a[7])<<4)
+	data[32] = byte(uint64(a[7]) >> 4)
+	data[33] = byte(uint64(a[7]) >> 12)
+	data[34] = byte(uint64(a[7]) >> 20)
+	data[35] = byte(uint64(a[7]) >> 28)
+}
+
+func pack8int64_37(data []byte, a [8]int64) {
+	_ = data[36]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<5)
+	data[5] = byte(uint64(a[1]) >> 3)
+	data[6] = byte(uint64(a[1]) >> 11)
+	data[7] = byte(uint64(a[1]) >> 19)
+	data[8] = byte(uint64(a[1]) >> 27)
+	data[9] = byte(uint64(a[1])>>35) | byte(uint64(a[2])<<2)
+	data[10] = byte(uint64(a[2]) >> 6)
+	data[11] = byte(uint64(a[2]) >> 14)
+	data[12] = byte(uint64(a[2]) >> 22)
+	data[13] = byte(uint64(a[2])>>30) | byte(uint64(a[3])<<7)
+	data[14] = byte(uint64(a[3]) >> 1)
+	data[15] = byte(uint64(a[3]) >> 9)
+	data[16] = byte(uint64(a[3]) >> 17)
+	data[17] = byte(uint64(a[3]) >> 25)
+	data[18] = byte(uint64(a[3])>>33) | byte(uint64(a[4])<<4)
+	data[19] = byte(uint64(a[4]) >> 4)
+	data[20] = byte(uint64(a[4]) >> 12)
+	data[21] = byte(uint64(a[4]) >> 20)
+	data[22] = byte(uint64(a[4]) >> 28)
+	data[23] = byte(uint64(a[4])>>36) | byte(uint64(a[5])<<1)
+	data[24] = byte(uint64(a[5]) >> 7)
+	data[25] = byte(uint64(a[5]) >> 15)
+	data[26] = byte(uint64(a[5]) >> 23)
+	data[27] = byte(uint64(a[5])>>31) | byte(uint64(a[6])<<6)
+	data[28] = byte(uint64(a[6]) >> 2)
+	data[29] = byte(uint64(a[6]) >> 10)
+	data[30] = byte(uint64(a[6]) >> 18)
+	data[31] = byte(uint64(a[6]) >> 26)
+	data[32] = byte(uint64(a[6])>>34) | byte(uint64(a[7])<<3)
+	data[33] = byte(uint64(a[7]) >> 5)
+	data[34] = byte(uint64(a[7]) >> 13)
+	data[35] = byte(uint64(a[7]) >> 21)
+	data[36] = byte(uint64(a[7]) >> 29)
+}
+
+func pack8int64_38(data []byte, a [8]int64) {
+	_ = data[37]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<6)
+	data[5] = byte(uint64(a[1]) >> 2)
+	data[6] = byte(uint64(a[1]) >> 10)
+	data[7] = byte(uint64(a[1]) >> 18)
+	data[8] = byte(uint64(a[1]) >> 26)
+	data[9] = byte(uint64(a[1])>>34) | byte(uint64(a[2])<<4)
+	data[10] = byte(uint64(a[2]) >> 4)
+	data[11] = byte(uint64(a[2]) >> 12)
+	data[12] = byte(uint64(a[2]) >> 20)
+	data[13] = byte(uint64(a[2]) >> 28)
+	data[14] = byte(uint64(a[2])>>36) | byte(uint64(a[3])<<2)
+	data[15] = byte(uint64(a[3]) >> 6)
+	data[16] = byte(uint64(a[3]) >> 14)
+	data[17] = byte(uint64(a[3]) >> 22)
+	data[18] = byte(uint64(a[3]) >> 30)
+	data[19] = byte(uint64(a[4]) << 0)
+	data[20] = byte(uint64(a[4]) >> 8)
+	data[21] = byte(uint64(a[4]) >> 16)
+	data[22] = byte(uint64(a[4]) >> 24)
+	data[23] = byte(uint64(a[4])>>32) | byte(uint64(a[5])<<6)
+	data[24] = byte(uint64(a[5]) >> 2)
+	data[25] = byte(uint64(a[5]) >> 10)
+	data[26] = byte(uint64(a[5]) >> 18)
+	data[27] = byte(uint64(a[5]) >> 26)
+	data[28] = byte(uint64(a[5])>>34) | byte(uint64(a[6])<<4)
+	data[29] = byte(uint64(a[6]) >> 4)
+	data[30] = byte(uint64(a[6]) >> 12)
+	data[31] = byte(uint64(a[6]) >> 20)
+	data[32] = byte(uint64(a[6]) >> 28)
+	data[33] = byte(uint64(a[6])>>36) | byte(uint64(a[7])<<2)
+	data[34] = byte(uint64(a[7]) >> 6)
+	data[35] = byte(uint64(a[7]) >> 14)
+	data[36] = byte(uint64(a[7]) >> 22)
+	data[37] = byte(uint64(a[7]) >> 30)
+}
+
+func pack8int64_39(data []byte, a [8]int64) {
+	_ = data[38]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0])>>32) | byte(uint64(a[1])<<7)
+	data[5] = byte(uint64(a[1]) >> 1)
+	data[6] = byte(uint64(a[1]) >> 9)
+	data[7] = byte(uint64(a[1]) >> 17)
+	data[8] = byte(uint64(a[1]) >> 25)
+	data[9] = byte(uint64(a[1])>>33) | byte(uint64(a[2])<<6)
+	data[10] = byte(uint64(a[2]) >> 2)
+	data[11] = byte(uint64(a[2]) >> 10)
+	data[12] = byte(uint64(a[2]) >> 18)
+	data[13] = byte(uint64(a[2]) >> 26)
+	data[14] = byte(uint64(a[2])>>34) | byte(uint64(a[3])<<5)
+	data[15] = byte(uint64(a[3]) >> 3)
+	data[16] = byte(uint64(a[3]) >> 11)
+	data[17] = byte(uint64(a[3]) >> 19)
+	data[18] = byte(uint64(a[3]) >> 27)
+	data[19] = byte(uint64(a[3])>>35) | byte(uint64(a[4])<<4)
+	data[20] = byte(uint64(a[4]) >> 4)
+	data[21] = byte(uint64(a[4]) >> 12)
+	data[22] = byte(uint64(a[4]) >> 20)
+	data[23] = byte(uint64(a[4]) >> 28)
+	data[24] = byte(uint64(a[4])>>36) | byte(uint64(a[5])<<3)
+	data[25] = byte(uint64(a[5]) >> 5)
+	data[26] = byte(uint64(a[5]) >> 13)
+	data[27] = byte(uint64(a[5]) >> 21)
+	data[28] = byte(uint64(a[5]) >> 29)
+	data[29] = byte(uint64(a[5])>>37) | byte(uint64(a[6])<<2)
+	data[30] = byte(uint64(a[6]) >> 6)
+	data[31] = byte(uint64(a[6]) >> 14)
+	data[32] = byte(uint64(a[6]) >> 22)
+	data[33] = byte(uint64(a[6]) >> 30)
+	data[34] = byte(uint64(a[6])>>38) | byte(uint64(a[7])<<1)
+	data[35] = byte(uint64(a[7]) >> 7)
+	data[36] = byte(uint64(a[7]) >> 15)
+	data[37] = byte(uint64(a[7]) >> 23)
+	data[38] = byte(uint64(a[7]) >> 31)
+}
+
+func pack8int64_40(data []byte, a [8]int64) {
+	_ = data[39]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[1]) << 0)
+	data[6] = byte(uint64(a[1]) >> 8)
+	data[7] = byte(uint64(a[1]) >> 16)
+	data[8] = byte(uint64(a[1]) >> 24)
+	data[9] = byte(uint64(a[1]) >> 32)
+	data[10] = byte(uint64(a[2]) << 0)
+	data[11] = byte(uint64(a[2]) >> 8)
+	data[12] = byte(uint64(a[2]) >> 16)
+	data[13] = byte(uint64(a[2]) >> 24)
+	data[14] = byte(uint64(a[2]) >> 32)
+	data[15] = byte(uint64(a[3]) << 0)
+	data[16] = byte(uint64(a[3]) >> 8)
+	data[17] = byte(uint64(a[3]) >> 16)
+	data[18] = byte(uint64(a[3]) >> 24)
+	data[19] = byte(uint64(a[3]) >> 32)
+	data[20] = byte(uint64(a[4]) << 0)
+	data[21] = byte(uint64(a[4]) >> 8)
+	data[22] = byte(uint64(a[4]) >> 16)
+	data[23] = byte(uint64(a[4]) >> 24)
+	data[24] = byte(uint64(a[4]) >> 32)
+	data[25] = byte(uint64(a[5]) << 0)
+	data[26] = byte(uint64(a[5]) >> 8)
+	data[27] = byte(uint64(a[5]) >> 16)
+	data[28] = byte(uint64(a[5]) >> 24)
+	data[29] = byte(uint64(a[5]) >> 32)
+	data[30] = byte(uint64(a[6]) << 0)
+	data[31] = byte(uint64(a[6]) >> 8)
+	data[32] = byte(uint64(a[6]) >> 16)
+	data[33] = byte(uint64(a[6]) >> 24)
+	data[34] = byte(uint64(a[6]) >> 32)
+	data[35] = byte(uint64(a[7]) << 0)
+	data[36] = byte(uint64(a[7]) >> 8)
+	data[37] = byte(uint64(a[7]) >> 16)
+	data[38] = byte(uint64(a[7]) >> 24)
+	data[39] = byte(uint64(a[7]) >> 32)
+}
+
+func pack8int64_41(data []byte, a [8]int64) {
+	_ = data[40]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<1)
+	data[6] = byte(uint64(a[1]) >> 7)
+	data[7] = byte(uint64(a[1]) >> 15)
+	data[8] = byte(uint64(a[1]) >> 23)
+	data[9] = byte(uint64(a[1]) >> 31)
+	data[10] = byte(uint64(a[1])>>39) | byte(uint64(a[2])<<2)
+	data[11] = byte(uint64(a[2]) >> 6)
+	data[12] = byte(uint64(a[2]) >> 14)
+	data[13] = byte(uint64(a[2]) >> 22)
+	data[14] = byte(uint64(a[2]) >> 30)
+	data[15] = byte(uint64(a[2])>>38) | byte(uint64(a[3])<<3)
+	data[16] = byte(uint64(a[3]) >> 5)
+	data[17] = byte(uint64(a[3]) >> 13)
+	data[18] = byte(uint64(a[3]) >> 21)
+	data[19] = byte(uint64(a[3]) >> 29)
+	data[20] = byte(uint64(a[3])>>37) | byte(uint64(a[4])<<4)
+	data[21] = byte(uint64(a[4]) >> 4)
+	data[22] = byte(uint64(a[4]) >> 12)
+	data[23] = byte(uint64(a[4]) >> 20)
+	data[24] = byte(uint64(a[4]) >> 28)
+	data[25] = byte(uint64(a[4])>>36) | byte(uint64(a[5])<<5)
+	data[26] = byte(uint64(a[5]) >> 3)
+	data[27] = byte(uint64(a[5]) >> 11)
+	data[28] = byte(uint64(a[5]) >> 19)
+	data[29] = byte(uint64(a[5]) >> 27)
+	data[30] = byte(uint64(a[5])>>35) | byte(uint64(a[6])<<6)
+	data[31] = byte(uint64(a[6]) >> 2)
+	data[32] = byte(uint64(a[6]) >> 10)
+	data[33] = byte(uint64(a[6]) >> 18)
+	data[34] = byte(uint64(a[6]) >> 26)
+	data[35] = byte(uint64(a[6])>>34) | byte(uint64(a[7])<<7)
+	data[36] = byte(uint64(a[7]) >> 1)
+	data[37] = byte(uint64(a[7]) >> 9)
+	data[38] = byte(uint64(a[7]) >> 17)
+	data[39] = byte(uint64(a[7]) >> 25)
+	data[40] = byte(uint64(a[7]) >> 33)
+}
+
+func pack8int64_42(data []byte, a [8]int64) {
+	_ = data[41]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<2)
+	data[6] = byte(uint64(a[1]) >> 6)
+	data[7] = byte(uint64(a[1]) >> 14)
+	data[8] = byte(uint64(a[1]) >> 22)
+	data[9] = byte(uint64(a[1]) >> 30)
+	data[10] = byte(uint64(a[1])>>38) | byte(uint64(a[2])<<4)
+	data[11] = byte(uint64(a[2]) >> 4)
+	data[12] = byte(uint64(a[2]) >> 12)
+	data[13] = byte(uint64(a[2]) >> 20)
+	data[14] = byte(uint64(a[2]) >> 28)
+	data[15] = byte(uint64(a[2])>>36) | byte(uint64(a[3])<<6)
+	data[16] = byte(uint64(a[3]) >> 2)
+	data[17] = byte(uint64(a[3]) >> 10)
+	data[18] = byte(uint64(a[3]) >> 18)
+	data[19] = byte(uint64(a[3]) >> 26)
+	data[20] = byte(uint64(a[3]) >> 34)
+	data[21] = byte(uint64(a[4]) << 0)
+	data[22] = byte(uint64(a[4]) >> 8)
+	data[23] = byte(uint64(a[4]) >> 16)
+	data[24] = byte(uint64(a[4]) >> 24)
+	data[25] = byte(uint64(a[4]) >> 32)
+	data[26] = byte(uint64(a[4])>>40) | byte(uint64(a[5])<<2)
+	data[27] = byte(uint64(a[5]) >> 6)
+	data[28] = byte(uint64(a[5]) >> 14)
+	data[29] = byte(uint64(a[5]) >> 22)
+	data[30] = byte(uint64(a[5]) >> 30)
+	data[31] = byte(uint64(a[5])>>38) | byte(uint64(a[6])<<4)
+	data[32] = byte(uint64(a[6]) >> 4)
+	data[33] = byte(uint64(a[6]) >> 12)
+	data[34] = byte(uint64(a[6]) >> 20)
+	data[35] = byte(uint64(a[6]) >> 28)
+	data[36] = byte(uint64(a[6])>>36) | byte(uint64(a[7])<<6)
+	data[37] = byte(uint64(a[7]) >> 2)
+	data[38] = byte(uint64(a[7]) >> 10)
+	data[39] = byte(uint64(a[7]) >> 18)
+	data[40] = byte(uint64(a[7]) >> 26)
+	data[41] = byte(uint64(a[7]) >> 34)
+}
+
+func pack8int64_43(data []byte, a [8]int64) {
+	_ = data[42]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<3)
+	data[6] = byte(uint64(a[1]) >> 5)
+	data[7] = byte(uint64(a[1]) >> 13)
+	data[8] = byte(uint64(a[1]) >> 21)
+	data[9] = byte(uint64(a[1]) >> 29)
+	data[10] = byte(uint64(a[1])>>37) | byte(uint64(a[2])<<6)
+	data[11] = byte(uint64(a[2]) >> 2)
+	data[12] = byte(uint64(a[2]) >> 10)
+	data[13] = byte(uint64(a[2]) >> 18)
+	data[14] = byte(uint64(a[2]) >> 26)
+	data[15] = byte(uint64(a[2]) >> 34)
+	data[16] = byte(uint64(a[2])>>42) | byte(uint64(a[3])<<1)
+	data[17] = byte(uint64(a[3]) >> 7)
+	data[18] = byte(uint64(a[3]) >> 15)
+	data[19] = byte(uint64(a[3]) >> 23)
+	data[20] = byte(uint64(a[3]) >> 31)
+	data[21] = byte(uint64(a[3])>>39) | byte(uint64(a[4])<<4)
+	data[22] = byte(uint64(a[4]) >> 4)
+	data[23] = byte(uint64(a[4]) >> 12)
+	data[24] = byte(uint64(a[4]) >> 20)
+	data[25] = byte(uint64(a[4]) >> 28)
+	data[26] = byte(uint64(a[4])>>36) | byte(uint64(a[5])<<7)
+	data[27] = byte(uint64(a[5]) >> 1)
+	data[28] = byte(uint64(a[5]) >> 9)
+	data[29] = byte(uint64(a[5]) >> 17)
+	data[30] = byte(uint64(a[5]) >> 25)
+	data[31] = byte(uint64(a[5]) >> 33)
+	data[32] = byte(uint64(a[5])>>41) | byte(uint64(a[6])<<2)
+	data[33] = byte(uint64(a[6]) >> 6)
+	data[34] = byte(uint64(a[6]) >> 14)
+	data[35] = byte(uint64(a[6]) >> 22)
+	data[36] = byte(uint64(a[6]) >> 30)
+	data[37] = byte(uint64(a[6])>>38) | byte(uint64(a[7])<<5)
+	data[38] = byte(uint64(a[7]) >> 3)
+	data[39] = byte(uint64(a[7]) >> 11)
+	data[40] = byte(uint64(a[7]) >> 19)
+	data[41] = byte(uint64(a[7]) >> 27)
+	data[42] = byte(uint64(a[7]) >> 35)
+}
+
+func pack8int64_44(data []byte, a [8]int64) {
+	_ = data[43]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<4)
+	data[6] = byte(uint64(a[1]) >> 4)
+	data[7] = byte(uint64(a[1]) >> 12)
+	data[8] = byte(uint64(a[1]) >> 20)
+	data[9] = byte(uint64(a[1]) >> 28)
+	data[10] = byte(uint64(a[1]) >> 36)
+	data[11] = byte(uint64(a[2]) << 0)
+	data[12] = byte(uint64(a[2]) >> 8)
+	data[13] = byte(uint64(a[2]) >> 16)
+	data[14] = byte(uint64(a[2]) >> 24)
+	data[15] = byte(uint64(a[2]) >> 32)
+	data[16] = byte(uint64(a[2])>>40) | byte(uint64(a[3])<<4)
+	data[17] = byte(uint64(a[3]) >> 4)
+	data[18] = byte(uint64(a[3]) >> 12)
+	data[19] = byte(uint64(a[3]) >> 20)
+	data[20] = byte(uint64(a[3]) >> 28)
+	data[21] = byte(uint64(a[3]) >> 36)
+	data[22] = byte(uint64(a[4]) << 0)
+	data[23] = byte(uint64(a[4]) >> 8)
+	data[24] = byte(uint64(a[4]) >> 16)
+	data[25] = byte(uint64(a[4]) >> 24)
+	data[26] = byte(uint64(a[4]) >> 32)
+	data[27] = byte(uint64(a[4])>>40) | byte(uint64(a[5])<<4)
+	data[28] = byte(uint64(a[5]) >> 4)
+	data[29] = byte(uint64(a[5]) >> 12)
+	data[30] = byte(uint64(a[5]) >> 20)
+	data[31] = byte(uint64(a[5]) >> 28)
+	data[32] = byte(uint64(a[5]) >> 36)
+	data[33] = byte(uint64(a[6]) << 0)
+	data[34] = byte(uint64(a[6]) >> 8)
+	data[35] = byte(uint64(a[6]) >> 16)
+	data[36] = byte(uint64(a[6]) >> 24)
+	data[37] = byte(uint64(a[6]) >> 32)
+	data[38] = byte(uint64(a[6])>>40) | byte(uint64(a[7])<<4)
+	data[39] = byte(uint64(a[7]) >> 4)
+	data[40] = byte(uint64(a[7]) >> 12)
+	data[41] = byte(uint64(a[7]) >> 20)
+	data[42] = byte(uint64(a[7]) >> 28)
+	data[43] = byte(uint64(a[7]) >> 36)
+}
+
+func pack8int64_45(data []byte, a [8]int64) {
+	_ = data[44]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<5)
+	data[6] = byte(uint64(a[1]) >> 3)
+	data[7] = byte(uint64(a[1]) >> 11)
+	data[8] = byte(uint64(a[1]) >> 19)
+	data[9] = byte(uint64(a[1]) >> 27)
+	data[10] = byte(uint64(a[1]) >> 35)
+	data[11] = byte(uint64(a[1])>>43) | byte(uint64(a[2])<<2)
+	data[12] = byte(uint64(a[2]) >> 6)
+	data[13] = byte(uint64(a[2]) >> 14)
+	data[14] = byte(uint64(a[2]) >> 22)
+	data[15] = byte(uint64(a[2]) >> 30)
+	data[16] = byte(uint64(a[2])>>38) | byte(uint64(a[3])<<7)
+	data[17] = byte(uint64(a[3]) >> 1)
+	data[18] = byte(uint64(a[3]) >> 9)
+	data[19] = byte(uint64(a[3]) >> 17)
+	data[20] = byte(uint64(a[3]) >> 25)
+	data[21] = byte(uint64(a[3]) >> 33)
+	data[22] = byte(uint64(a[3])>>41) | byte(uint64(a[4])<<4)
+	data[23] = byte(uint64(a[4]) >> 4)
+	data[24] = byte(uint64(a[4]) >> 12)
+	data[25] = byte(uint64(a[4]) >> 20)
+	data[26] = byte(uint64(a[4]) >> 28)
+	data[27] = byte(uint64(a[4]) >> 36)
+	data[28] = byte(uint64(a[4])>>44) | byte(uint64(a[5])<<1)
+	data[29] = byte(uint64(a[5]) >> 7)
+	data[30] = byte(uint64(a[5]) >> 15)
+	data[31] = byte(uint64(a[5]) >> 23)
+	data[32] = byte(uint64(a[5]) >> 31)
+	data[33] = byte(uint64(a[5])>>39) | byte(uint64(a[6])<<6)
+	data[34] = byte(uint64(a[6]) >> 2)
+	data[35] = byte(uint64(a[6]) >> 10)
+	data[36] = byte(uint64(a[6]) >> 18)
+	data[37] = byte(uint64(a[6]) >> 26)
+	data[38] = byte(uint64(a[6]) >> 34)
+	data[39] = byte(uint64(a[6])>>42) | byte(uint64(a[7])<<3)
+	data[40] = byte(uint64(a[7]) >> 5)
+	data[41] = byte(uint64(a[7]) >> 13)
+	data[42] = byte(uint64(a[7]) >> 21)
+	data[43] = byte(uint64(a[7]) >> 29)
+	data[44] = byte(uint64(a[7]) >> 37)
+}
+
+func pack8int64_46(data []byte, a [8]int64) {
+	_ = data[45]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<6)
+	data[6] = byte(uint64(a[1]) >> 2)
+	data[7] = byte(uint64(a[1]) >> 10)
+	data[8] = byte(uint64(a[1]) >> 18)
+	data[9] = byte(uint64(a[1]) >> 26)
+	data[10] = byte(uint64(a[1]) >> 34)
+	data[11] = byte(uint64(a[1])>>42) | byte(uint64(a[2])<<4)
+	data[12] = byte(uint64(a[2]) >> 4)
+	data[13] = byte(uint64(a[2]) >> 12)
+	data[14] = byte(uint64(a[2]) >> 20)
+	data[15] = byte(uint64(a[2]) >> 28)
+	data[16] = byte(uint64(a[2]) >> 36)
+	data[17] = byte(uint64(a[2])>>44) | byte(uint64(a[3])<<2)
+	data[18] = byte(uint64(a[3]) >> 6)
+	data[19] = byte(uint64(a[3]) >> 14)
+	data[20] = byte(uint64(a[3]) >> 22)
+	data[21] = byte(uint64(a[3]) >> 30)
+	data[22] = byte(uint64(a[3]) >> 38)
+	data[23] = byte(uint64(a[4]) << 0)
+	data[24] = byte(uint64(a[4]) >> 8)
+	data[25] = byte(uint64(a[4]) >> 16)
+	data[26] = byte(uint64(a[4]) >> 24)
+	data[27] = byte(uint64(a[4]) >> 32)
+	data[28] = byte(uint64(a[4])>>40) | byte(uint64(a[5])<<6)
+	data[29] = byte(uint64(a[5]) >> 2)
+	data[30] = byte(uint64(a[5]) >> 10)
+	data[31] = byte(uint64(a[5]) >> 18)
+	data[32] = byte(uint64(a[5]) >> 26)
+	data[33] = byte(uint64(a[5]) >> 34)
+	data[34] = byte(uint64(a[5])>>42) | byte(uint64(a[6])<<4)
+	data[35] = byte(uint64(a[6]) >> 4)
+	data[36] = byte(uint64(a[6]) >> 12)
+	data[37] = byte(uint64(a[6]) >> 20)
+	data[38] = byte(uint64(a[6]) >> 28)
+	data[39] = byte(uint64(a[6]) >> 36)
+	data[40] = byte(uint64(a[6])>>44) | byte(uint64(a[7])<<2)
+	data[41] = byte(uint64(a[7]) >> 6)
+	data[42] = byte(uint64(a[7]) >> 14)
+	data[43] = byte(uint64(a[7]) >> 22)
+	data[44] = byte(uint64(a[7]) >> 30)
+	data[45] = byte(uint64(a[7]) >> 38)
+}
+
+func pack8int64_47(data []byte, a [8]int64) {
+	_ = data[46]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0])>>40) | byte(uint64(a[1])<<7)
+	data[6] = byte(uint64(a[1]) >> 1)
+	data[7] = byte(uint64(a[1]) >> 9)
+	data[8] = byte(uint64(a[1]) >> 17)
+	data[9] = byte(uint64(a[1]) >> 25)
+	data[10] = byte(uint64(a[1]) >> 33)
+	data[11] = byte(uint64(a[1])>>41) | byte(uint64(a[2])<<6)
+	data[12] = byte(uint64(a[2]) >> 2)
+	data[13] = byte(uint64(a[2]) >> 10)
+	data[14] = byte(uint64(a[2]) >> 18)
+	data[15] = byte(uint64(a[2]) >> 26)
+	data[16] = byte(uint64(a[2]) >> 34)
+	data[17] = byte(uint64(a[2])>>42) | byte(uint64(a[3])<<5)
+	data[18] = byte(uint64(a[3]) >> 3)
+	data[19] = byte(uint64(a[3]) >> 11)
+	data[20] = byte(uint64(a[3]) >> 19)
+	data[21] = byte(uint64(a[3]) >> 27)
+	data[22] = byte(uint64(a[3]) >> 35)
+	data[23] = byte(uint64(a[3])>>43) | byte(uint64(a[4])<<4)
+	data[24] = byte(uint64(a[4]) >> 4)
+	data[25] = byte(uint64(a[4]) >> 12)
+	data[26] = byte(uint64(a[4]) >> 20)
+	data[27] = byte(uint64(a[4]) >> 28)
+	data[28] = byte(uint64(a[4]) >> 36)
+	data[29] = byte(uint64(a[4])>>44) | byte(uint64(a[5])<<3)
+	data[30] = byte(uint64(a[5]) >> 5)
+	data[31] = byte(uint64(a[5]) >> 13)
+	data[32] = byte(uint64(a[5]) >> 21)
+	data[33] = byte(uint64(a[5]) >> 29)
+	data[34] = byte(uint64(a[5]) >> 37)
+	data[35] = byte(uint64(a[5])>>45) | byte(uint64(a[6])<<2)
+	data[36] = byte(uint64(a[6]) >> 6)
+	data[37] = byte(uint64(a[6]) >> 14)
+	data[38] = byte(uint64(a[6]) >> 22)
+	data[39] = byte(uint64(a[6]) >> 30)
+	data[40] = byte(uint64(a[6]) >> 38)
+	data[41] = byte(uint64(a[6])>>46) | byte(uint64(a[7])<<1)
+	data[42] = byte(uint64(a[7]) >> 7)
+	data[43] = byte(uint64(a[7]) >> 15)
+	data[44] = byte(uint64(a[7]) >> 23)
+	data[45] = byte(uint64(a[7]) >> 31)
+	data[46] = byte(uint64(a[7]) >> 39)
+}
+
+func pack8int64_48(data []byte, a [8]int64) {
+	_ = data[47]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[1]) << 0)
+	data[7] = byte(uint64(a[1]) >> 8)
+	data[8] = byte(uint64(a[1]) >> 16)
+	data[9] = byte(uint64(a[1]) >> 24)
+	data[10] = byte(uint64(a[1]) >> 32)
+	data[11] = byte(uint64(a[1]) >> 40)
+	data[12] = byte(uint64(a[2]) << 0)
+	data[13] = byte(uint64(a[2]) >> 8)
+	data[14] = byte(uint64(a[2]) >> 16)
+	data[15] = byte(uint64(a[2]) >> 24)
+	data[16] = byte(uint64(a[2]) >> 32)
+	data[17] = byte(uint64(a[2]) >> 40)
+	data[18] = byte(uint64(a[3]) << 0)
+	data[19] = byte(uint64(a[3]) >> 8)
+	data[20] = byte(uint64(a[3]) >> 16)
+	data[21] = byte(uint64(a[3]) >> 24)
+	data[22] = byte(uint64(a[3]) >> 32)
+	data[23] = byte(uint64(a[3]) >> 40)
+	data[24] = byte(uint64(a[4]) << 0)
+	data[25] = byte(uint64(a[4]) >> 8)
+	data[26] = byte(uint64(a[4]) >> 16)
+	data[27] = byte(uint64(a[4]) >> 24)
+	data[28] = byte(uint64(a[4]) >> 32)
+	data[29] = byte(uint64(a[4]) >> 40)
+	data[30] = byte(uint64(a[5]) << 0)
+	data[31] = byte(uint64(a[5]) >> 8)
+	data[32] = byte(uint64(a[5]) >> 16)
+	data[33] = byte(uint64(a[5]) >> 24)
+	data[34] = byte(uint64(a[5]) >> 32)
+	data[35] = byte(uint64(a[5]) >> 40)
+	data[36] = byte(uint64(a[6]) << 0)
+	data[37] = byte(uint64(a[6]) >> 8)
+	data[38] = byte(uint64(a[6]) >> 16)
+	data[39] = byte(uint64(a[6]) >> 24)
+	data[40] = byte(uint64(a[6]) >> 32)
+	data[41] = byte(uint64(a[6]) >> 40)
+	data[42] = byte(uint64(a[7]) << 0)
+	data[43] = byte(uint64(a[7]) >> 8)
+	data[44] = byte(uint64(a[7]) >> 16)
+	data[45] = byte(uint64(a[7]) >> 24)
+	data[46] = byte(uint64(a[7]) >> 32)
+	data[47] = byte(uint64(a[7]) >> 40)
+}
+
+func pack8int64_49(data []byte, a [8]int64) {
+	_ = data[48]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<1)
+	data[7] = byte(uint64(a[1]) >> 7)
+	data[8] = byte(uint64(a[1]) >> 15)
+	data[9] = byte(uint64(a[1]) >> 23)
+	data[10] = byte(uint64(a[1]) >> 31)
+	data[11] = byte(uint64(a[1]) >> 39)
+	data[12] = byte(uint64(a[1])>>47) | byte(uint64(a[2])<<2)
+	data[13] = byte(uint64(a[2]) >> 6)
+	data[14] = byte(uint64(a[2]) >> 14)
+	data[15] = byte(uint64(a[2]) >> 22)
+	data[16] = byte(uint64(a[2]) >> 30)
+	data[17] = byte(uint64(a[2]) >> 38)
+	data[18] = byte(uint64(a[2])>>46) | byte(uint64(a[3])<<3)
+	data[19] = byte(uint64(a[3]) >> 5)
+	data[20] = byte(uint64(a[3]) >> 13)
+	data[21] = byte(uint64(a[3]) >> 21)
+	data[22] = byte(uint64(a[3]) >> 29)
+	data[23] = byte(uint64(a[3]) >> 37)
+	data[24] = byte(uint64(a[3])>>45) | byte(uint64(a[4])<<4)
+	data[25] = byte(uint64(a[4]) >> 4)
+	data[26] = byte(uint64(a[4]) >> 12)
+	data[27] = byte(uint64(a[4]) >> 20)
+	data[28] = byte(uint64(a[4]) >> 28)
+	data[29] = byte(uint64(a[4]) >> 36)
+	data[30] = byte(uint64(a[4])>>44) | byte(uint64(a[5])<<5)
+	data[31] = byte(uint64(a[5]) >> 3)
+	data[32] = byte(uint64(a[5]) >> 11)
+	data[33] = byte(uint64(a[5]) >> 19)
+	data[34] = byte(uint64(a[5]) >> 27)
+	data[35] = byte(uint64(a[5]) >> 35)
+	data[36] = byte(uint64(a[5])>>43) | byte(uint64(a[6])<<6)
+	data[37] = byte(uint64(a[6]) >> 2)
+	data[38] = byte(uint64(a[6]) >> 10)
+	data[39] = byte(uint64(a[6]) >> 18)
+	data[40] = byte(uint64(a[6]) >> 26)
+	data[41] = byte(uint64(a[6]) >> 34)
+	data[42] = byte(uint64(a[6])>>42) | byte(uint64(a[7])<<7)
+	data[43] = byte(uint64(a[7]) >> 1)
+	data[44] = byte(uint64(a[7]) >> 9)
+	data[45] = byte(uint64(a[7]) >> 17)
+	data[46] = byte(uint64(a[7]) >> 25)
+	data[47] = byte(uint64(a[7]) >> 33)
+	data[48] = byte(uint64(a[7]) >> 41)
+}
+
+func pack8int64_50(data []byte, a [8]int64) {
+	_ = data[49]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<2)
+	data[7] = byte(uint64(a[1]) >> 6)
+	data[8] = byte(uint64(a[1]) >> 14)
+	data[9] = byte(uint64(a[1]) >> 22)
+	data[10] = byte(uint64(a[1]) >> 30)
+	data[11] = byte(uint64(a[1]) >> 38)
+	data[12] = byte(uint64(a[1])>>46) | byte(uint64(a[2])<<4)
+	data[13] = byte(uint64(a[2]) >> 4)
+	data[14] = byte(uint64(a[2]) >> 12)
+	data[15] = byte(uint64(a[2]) >> 20)
+	data[16] = byte(uint64(a[2]) >> 28)
+	data[17] = byte(uint64(a[2]) >> 36)
+	data[18] = byte(uint64(a[2])>>44) | byte(uint64(a[3])<<6)
+	data[19] = byte(uint64(a[3]) >> 2)
+	data[20] = byte(uint64(a[3]) >> 10)
+	data[21] = byte(uint64(a[3]) >> 18)
+	data[22] = byte(uint64(a[3]) >> 26)
+	data[23] = byte(uint64(a[3]) >> 34)
+	data[24] = byte(uint64(a[3]) >> 42)
+	data[25] = byte(uint64(a[4]) << 0)
+	data[26] = byte(uint64(a[4]) >> 8)
+	data[27] = byte(uint64(a[4]) >> 16)
+	data[28] = byte(uint64(a[4]) >> 24)
+	data[29] = byte(uint64(a[4]) >> 32)
+	data[30] = byte(uint64(a[4]) >> 40)
+	data[31] = byte(uint64(a[4])>>48) | byte(uint64(a[5])<<2)
+	data[32] = byte(uint64(a[5]) >> 6)
+	data[33] = byte(uint64(a[5]) >> 14)
+	data[34] = byte(uint64(a[5]) >> 22)
+	data[35] = byte(uint64(a[5]) >> 30)
+	data[36] = byte(uint64(a[5]) >> 38)
+	data[37] = byte(uint64(a[5])>>46) | byte(uint64(a[6])<<4)
+	data[38] = byte(uint64(a[6]) >> 4)
+	data[39] = byte(uint64(a[6]) >> 12)
+	data[40] = byte(uint64(a[6]) >> 20)
+	data[41] = byte(uint64(a[6]) >> 28)
+	data[42] = byte(uint64(a[6]) >> 36)
+	data[43] = byte(uint64(a[6])>>44) | byte(uint64(a[7])<<6)
+	data[44] = byte(uint64(a[7]) >> 2)
+	data[45] = byte(uint64(a[7]) >> 10)
+	data[46] = byte(uint64(a[7]) >> 18)
+	data[47] = byte(uint64(a[7]) >> 26)
+	data[48] = byte(uint64(a[7]) >> 34)
+	data[49] = byte(uint64(a[7]) >> 42)
+}
+
+func pack8int64_51(data []byte, a [8]int64) {
+	_ = data[50]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<3)
+	data[7] = byte(uint64(a[1]) >> 5)
+	data[8] = byte(uint64(a[1]) >> 13)
+	data[9] = byte(uint64(a[1]) >> 21)
+	data[10] = byte(uint64(a[1]) >> 29)
+	data[11] = byte(uint64(a[1]) >> 37)
+	data[12] = byte(uint64(a[1])>>45) | byte(uint64(a[2])<<6)
+	data[13] = byte(uint64(a[2]) >> 2)
+	data[14] = byte(uint64(a[2]) >> 10)
+	data[15] = byte(uint64(a[2]) >> 18)
+	data[16] = byte(uint64(a[2]) >> 26)
+	data[17] = byte(uint64(a[2]) >> 34)
+	data[18] = byte(uint64(a[2]) >> 42)
+	data[19] = byte(uint64(a[2])>>50) | byte(uint64(a[3])<<1)
+	data[20] = byte(uint64(a[3]) >> 7)
+	data[21] = byte(uint64(a[3]) >> 15)
+	data[22] = byte(uint64(a[3]) >> 23)
+	data[23] = byte(uint64(a[3]) >> 31)
+	data[24] = byte(uint64(a[3]) >> 39)
+	data[25] = byte(uint64(a[3])>>47) | byte(uint64(a[4])<<4)
+	data[26] = byte(uint64(a[4]) >> 4)
+	data[27] = byte(uint64(a[4]) >> 12)
+	data[28] = byte(uint64(a[4]) >> 20)
+	data[29] = byte(uint64(a[4]) >> 28)
+	data[30] = byte(uint64(a[4]) >> 36)
+	data[31] = byte(uint64(a[4])>>44) | byte(uint64(a[5])<<7)
+	data[32] = byte(uint64(a[5]) >> 1)
+	data[33] = byte(uint64(a[5]) >> 9)
+	data[34] = byte(uint64(a[5]) >> 17)
+	data[35] = byte(uint64(a[5]) >> 25)
+	data[36] = byte(uint64(a[5]) >> 33)
+	data[37] = byte(uint64(a[5]) >> 41)
+	data[38] = byte(uint64(a[5])>>49) | byte(uint64(a[6])<<2)
+	data[39] = byte(uint64(a[6]) >> 6)
+	data[40] = byte(uint64(a[6]) >> 14)
+	data[41] = byte(uint64(a[6]) >> 22)
+	data[42] = byte(uint64(a[6]) >> 30)
+	data[43] = byte(uint64(a[6]) >> 38)
+	data[44] = byte(uint64(a[6])>>46) | byte(uint64(a[7])<<5)
+	data[45] = byte(uint64(a[7]) >> 3)
+	data[46] = byte(uint64(a[7]) >> 11)
+	data[47] = byte(uint64(a[7]) >> 19)
+	data[48] = byte(uint64(a[7]) >> 27)
+	data[49] = byte(uint64(a[7]) >> 35)
+	data[50] = byte(uint64(a[7]) >> 43)
+}
+
+func pack8int64_52(data []byte, a [8]int64) {
+	_ = data[51]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<4)
+	data[7] = byte(uint64(a[1]) >> 4)
+	data[8] = byte(uint64(a[1]) >> 12)
+	data[9] = byte(uint64(a[1]) >> 20)
+	data[10] = byte(uint64(a[1]) >> 28)
+	data[11] = byte(uint64(a[1]) >> 36)
+	data[12] = byte(uint64(a[1]) >> 44)
+	data[13] = byte(uint64(a[2]) << 0)
+	data[14] = byte(uint64(a[2]) >> 8)
+	data[15] = byte(uint64(a[2]) >> 16)
+	data[16] = byte(uint64(a[2]) >> 24)
+	data[17] = byte(uint64(a[2]) >> 32)
+	data[18] = byte(uint64(a[2]) >> 40)
+	data[19] = byte(uint64(a[2])>>48) | byte(uint64(a[3])<<4)
+	data[20] = byte(uint64(a[3]) >> 4)
+	data[21] = byte(uint64(a[3]) >> 12)
+	data[22] = byte(uint64(a[3]) >> 20)
+	data[23] = byte(uint64(a[3]) >> 28)
+	data[24] = byte(uint64(a[3]) >> 36)
+	data[25] = byte(uint64(a[3]) >> 44)
+	data[26] = byte(uint64(a[4]) << 0)
+	data[27] = byte(uint64(a[4]) >> 8)
+	data[28] = byte(uint64(a[4]) >> 16)
+	data[29] = byte(uint64(a[4]) >> 24)
+	data[30] = byte(uint64(a[4]) >> 32)
+	data[31] = byte(uint64(a[4]) >> 40)
+	data[32] = byte(uint64(a[4])>>48) | byte(uint64(a[5])<<4)
+	data[33] = byte(uint64(a[5]) >> 4)
+	data[34] = byte(uint64(a[5]) >> 12)
+	data[35] = byte(uint64(a[5]) >> 20)
+	data[36] = byte(uint64(a[5]) >> 28)
+	data[37] = byte(uint64(a[5]) >> 36)
+	data[38] = byte(uint64(a[5]) >> 44)
+	data[39] = byte(uint64(a[6]) << 0)
+	data[40] = byte(uint64(a[6]) >> 8)
+	data[41] = byte(uint64(a[6]) >> 16)
+	data[42] = byte(uint64(a[6]) >> 24)
+	data[43] = byte(uint64(a[6]) >> 32)
+	data[44] = byte(uint64(a[6]) >> 40)
+	data[45] = byte(uint64(a[6])>>48) | byte(uint64(a[7])<<4)
+	data[46] = byte(uint64(a[7]) >> 4)
+	data[47] = byte(uint64(a[7]) >> 12)
+	data[48] = byte(uint64(a[7]) >> 20)
+	data[49] = byte(uint64(a[7]) >> 28)
+	data[50] = byte(uint64(a[7]) >> 36)
+	data[51] = byte(uint64(a[7]) >> 44)
+}
+
+func pack8int64_53(data []byte, a [8]int64) {
+	_ = data[52]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<5)
+	data[7] = byte(uint64(a[1]) >> 3)
+	data[8] = byte(uint64(a[1]) >> 11)
+	data[9] = byte(uint64(a[1]) >> 19)
+	data[10] = byte(uint64(a[1]) >> 27)
+	data[11] = byte(uint64(a[1]) >> 35)
+	data[12] = byte(uint64(a[1]) >> 43)
+	data[13] = byte(uint64(a[1])>>51) | byte(uint64(a[2])<<2)
+	data[14] = byte(uint64(a[2]) >> 6)
+	data[15] = byte(uint64(a[2]) >> 14)
+	data[16] = byte(uint64(a[2]) >> 22)
+	data[17] = byte(uint64(a[2]) >> 30)
+	data[18] = byte(uint64(a[2]) >> 38)
+	data[19] = byte(uint64(a[2])>>46) | byte(uint64(a[3])<<7)
+	data[20] = byte(uint64(a[3]) >> 1)
+	data[21] = byte(uint64(a[3]) >> 9)
+	data[22] = byte(uint64(a[3]) >> 17)
+	data[23] = byte(uint64(a[3]) >> 25)
+	data[24] = byte(uint64(a[3]) >> 33)
+	data[25] = byte(uint64(a[3]) >> 41)
+	data[26] = byte(uint64(a[3])>>49) | byte(uint64(a[4])<<4)
+	data[27] = byte(uint64(a[4]) >> 4)
+	data[28] = byte(uint64(a[4]) >> 12)
+	data[29] = byte(uint64(a[4]) >> 20)
+	data[30] = byte(uint64(a[4]) >> 28)
+	data[31] = byte(uint64(a[4]) >> 36)
+	data[32] = byte(uint64(a[4]) >> 44)
+	data[33] = byte(uint64(a[4])>>52) | byte(uint64(a[5])<<1)
+	data[34] = byte(uint64(a[5]) >> 7)
+	data[35] = byte(uint64(a[5]) >> 15)
+	data[36] = byte(uint64(a[5]) >> 23)
+	data[37] = byte(uint64(a[5]) >> 31)
+	data[38] = byte(uint64(a[5]) >> 39)
+	data[39] = byte(uint64(a[5])>>47) | byte(uint64(a[6])<<6)
+	data[40] = byte(uint64(a[6]) >> 2)
+	data[41] = byte(uint64(a[6]) >> 10)
+	data[42] = byte(uint64(a[6]) >> 18)
+	data[43] = byte(uint64(a[6]) >> 26)
+	data[44] = byte(uint64(a[6]) >> 34)
+	data[45] = byte(uint64(a[6]) >> 42)
+	data[46] = byte(uint64(a[6])>>50) | byte(uint64(a[7])<<3)
+	data[47] = byte(uint64(a[7]) >> 5)
+	data[48] = byte(uint64(a[7]) >> 13)
+	data[49] = byte(uint64(a[7]) >> 21)
+	data[50] = byte(uint64(a[7]) >> 29)
+	data[51] = byte(uint64(a[7]) >> 37)
+	data[52] = byte(uint64(a[7]) >> 45)
+}
+
+func pack8int64_54(data []byte, a [8]int64) {
+	_ = data[53]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<6)
+	data[7] = byte(uint64(a[1]) >> 2)
+	data[8] = byte(uint64(a[1]) >> 10)
+	data[9] = byte(uint64(a[1]) >> 18)
+	data[10] = byte(uint64(a[1]) >> 26)
+	data[11] = byte(uint64(a[1]) >> 34)
+	data[12] = byte(uint64(a[1]) >> 42)
+	data[13] = byte(uint64(a[1])>>50) | byte(uint64(a[2])<<4)
+	data[14] = byte(uint64(a[2]) >> 4)
+	data[15] = byte(uint64(a[2]) >> 12)
+	data[16] = byte(uint64(a[2]) >> 20)
+	data[17] = byte(uint64(a[2]) >> 28)
+	data[18] = byte(uint64(a[2]) >> 36)
+	data[19] = byte(uint64(a[2]) >> 44)
+	data[20] = byte(uint64(a[2])>>52) | byte(uint64(a[3])<<2)
+	data[21] = byte(uint64(a[3]) >> 6)
+	data[22] = byte(uint64(a[3]) >> 14)
+	data[23] = byte(uint64(a[3]) >> 22)
+	data[24] = byte(uint64(a[3]) >> 30)
+	data[25] = byte(uint64(a[3]) >> 38)
+	data[26] = byte(uint64(a[3]) >> 46)
+	data[27] = byte(uint64(a[4]) << 0)
+	data[28] = byte(uint64(a[4]) >> 8)
+	data[29] = byte(uint64(a[4]) >> 16)
+	data[30] = byte(uint64(a[4]) >> 24)
+	data[31] = byte(uint64(a[4]) >> 32)
+	data[32] = byte(uint64(a[4]) >> 40)
+	data[33] = byte(uint64(a[4])>>48) | byte(uint64(a[5])<<6)
+	data[34] = byte(uint64(a[5]) >> 2)
+	data[35] = byte(uint64(a[5]) >> 10)
+	data[36] = byte(uint64(a[5]) >> 18)
+	data[37] = byte(uint64(a[5]) >> 26)
+	data[38] = byte(uint64(a[5]) >> 34)
+	data[39] = byte(uint64(a[5]) >> 42)
+	data[40] = byte(uint64(a[5])>>50) | byte(uint64(a[6])<<4)
+	data[41] = byte(uint64(a[6]) >> 4)
+	data[42] = byte(uint64(a[6]) >> 12)
+	data[43] = byte(uint64(a[6]) >> 20)
+	data[44] = byte(uint64(a[6]) >> 28)
+	data[45] = byte(uint64(a[6]) >> 36)
+	data[46] = byte(uint64(a[6]) >> 44)
+	data[47] = byte(uint64(a[6])>>52) | byte(uint64(a[7])<<2)
+	data[48] = byte(uint64(a[7]) >> 6)
+	data[49] = byte(uint64(a[7]) >> 14)
+	data[50] = byte(uint64(a[7]) >> 22)
+	data[51] = byte(uint64(a[7]) >> 30)
+	data[52] = byte(uint64(a[7]) >> 38)
+	data[53] = byte(uint64(a[7]) >> 46)
+}
+
+func pack8int64_55(data []byte, a [8]int64) {
+	_ = data[54]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0])>>48) | byte(uint64(a[1])<<7)
+	data[7] = byte(uint64(a[1]) >> 1)
+	data[8] = byte(uint64(a[1]) >> 9)
+	data[9] = byte(uint64(a[1]) >> 17)
+	data[10] = byte(uint64(a[1]) >> 25)
+	data[11] = byte(uint64(a[1]) >> 33)
+	data[12] = byte(uint64(a[1]) >> 41)
+	data[13] = byte(uint64(a[1])>>49) | byte(uint64(a[2])<<6)
+	data[14] = byte(uint64(a[2]) >> 2)
+	data[15] = byte(uint64(a[2]) >> 10)
+	data[16] = byte(uint64(a[2]) >> 18)
+	data[17] = byte(uint64(a[2]) >> 26)
+	data[18] = byte(uint64(a[2]) >> 34)
+	data[19] = byte(uint64(a[2]) >> 42)
+	data[20] = byte(uint64(a[2])>>50) | byte(uint64(a[3])<<5)
+	data[21] = byte(uint64(a[3]) >> 3)
+	data[22] = byte(uint64(a[3]) >> 11)
+	data[23] = byte(uint64(a[3]) >> 19)
+	data[24] = byte(uint64(a[3]) >> 27)
+	data[25] = byte(uint64(a[3]) >> 35)
+	data[26] = byte(uint64(a[3]) >> 43)
+	data[27] = byte(uint64(a[3])>>51) | byte(uint64(a[4])<<4)
+	data[28] = byte(uint64(a[4]) >> 4)
+	data[29] = byte(uint64(a[4]) >> 12)
+	data[30] = byte(uint64(a[4]) >> 20)
+	data[31] = byte(uint64(a[4]) >> 28)
+	data[32] = byte(uint64(a[4]) >> 36)
+	data[33] = byte(uint64(a[4]) >> 44)
+	data[34] = byte(uint64(a[4])>>52) | byte(uint64(a[5])<<3)
+	data[35] = byte(uint64(a[5]) >> 5)
+	data[36] = byte(uint64(a[5]) >> 13)
+	data[37] = byte(uint64(a[5]) >> 21)
+	data[38] = byte(uint64(a[5]) >> 29)
+	data[39] = byte(uint64(a[5]) >> 37)
+	data[40] = byte(uint64(a[5]) >> 45)
+	data[41] = byte(uint64(a[5])>>53) | byte(uint64(a[6])<<2)
+	data[42] = byte(uint64(a[6]) >> 6)
+	data[43] = byte(uint64(a[6]) >> 14)
+	data[44] = byte(uint64(a[6]) >> 22)
+	data[45] = byte(uint64(a[6]) >> 30)
+	data[46] = byte(uint64(a[6]) >> 38)
+	data[47] = byte(uint64(a[6]) >> 46)
+	data[48] = byte(uint64(a[6])>>54) | byte(uint64(a[7])<<1)
+	data[49] = byte(uint64(a[7]) >> 7)
+	data[50] = byte(uint64(a[7]) >> 15)
+	data[51] = byte(uint64(a[7]) >> 23)
+	data[52] = byte(uint64(a[7]) >> 31)
+	data[53] = byte(uint64(a[7]) >> 39)
+	data[54] = byte(uint64(a[7]) >> 47)
+}
+
+func pack8int64_56(data []byte, a [8]int64) {
+	_ = data[55]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[1]) << 0)
+	data[8] = byte(uint64(a[1]) >> 8)
+	data[9] = byte(uint64(a[1]) >> 16)
+	data[10] = byte(uint64(a[1]) >> 24)
+	data[11] = byte(uint64(a[1]) >> 32)
+	data[12] = byte(uint64(a[1]) >> 40)
+	data[13] = byte(uint64(a[1]) >> 48)
+	data[14] = byte(uint64(a[2]) << 0)
+	data[15] = byte(uint64(a[2]) >> 8)
+	data[16] = byte(uint64(a[2]) >> 16)
+	data[17] = byte(uint64(a[2]) >> 24)
+	data[18] = byte(uint64(a[2]) >> 32)
+	data[19] = byte(uint64(a[2]) >> 40)
+	data[20] = byte(uint64(a[2]) >> 48)
+	data[21] = byte(uint64(a[3]) << 0)
+	data[22] = byte(uint64(a[3]) >> 8)
+	data[23] = byte(uint64(a[3]) >> 16)
+	data[24] = byte(uint64(a[3]) >> 24)
+	data[25] = byte(uint64(a[3]) >> 32)
+	data[26] = byte(uint64(a[3]) >> 40)
+	data[27] = byte(uint64(a[3]) >> 48)
+	data[28] = byte(uint64(a[4]) << 0)
+	data[29] = byte(uint64(a[4]) >> 8)
+	data[30] = byte(uint64(a[4]) >> 16)
+	data[31] = byte(uint64(a[4]) >> 24)
+	data[32] = byte(uint64(a[4]) >> 32)
+	data[33] = byte(uint64(a[4]) >> 40)
+	data[34] = byte(uint64(a[4]) >> 48)
+	data[35] = byte(uint64(a[5]) << 0)
+	data[36] = byte(uint64(a[5]) >> 8)
+	data[37] = byte(uint64(a[5]) >> 16)
+	data[38] = byte(uint64(a[5]) >> 24)
+	data[39] = byte(uint64(a[5]) >> 32)
+	data[40] = byte(uint64(a[5]) >> 40)
+	data[41] = byte(uint64(a[5]) >> 48)
+	data[42] = byte(uint64(a[6]) << 0)
+	data[43] = byte(uint64(a[6]) >> 8)
+	data[44] = byte(uint64(a[6]) >> 16)
+	data[45] = byte(uint64(a[6]) >> 24)
+	data[46] = byte(uint64(a[6]) >> 32)
+	data[47] = byte(uint64(a[6]) >> 40)
+	data[48] = byte(uint64(a[6]) >> 48)
+	data[49] = byte(uint64(a[7]) << 0)
+	data[50] = byte(uint64(a[7]) >> 8)
+	data[51] = byte(uint64(a[7]) >> 16)
+	data[52] = byte(uint64(a[7]) >> 24)
+	data[53] = byte(uint64(a[7]) >> 32)
+	data[54] = byte(uint64(a[7]) >> 40)
+	data[55] = byte(uint64(a[7]) >> 48)
+}
+
+func pack8int64_57(data []byte, a [8]int64) {
+	_ = data[56]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<1)
+	data[8] = byte(uint64(a[1]) >> 7)
+	data[9] = byte(uint64(a[1]) >> 15)
+	data[10] = byte(uint64(a[1]) >> 23)
+	data[11] = byte(uint64(a[1]) >> 31)
+	data[12] = byte(uint64(a[1]) >> 39)
+	data[13] = byte(uint64(a[1]) >> 47)
+	data[14] = byte(uint64(a[1])>>55) | byte(uint64(a[2])<<2)
+	data[15] = byte(uint64(a[2]) >> 6)
+	data[16] = byte(uint64(a[2]) >> 14)
+	data[17] = byte(uint64(a[2]) >> 22)
+	data[18] = byte(uint64(a[2]) >> 30)
+	data[19] = byte(uint64(a[2]) >> 38)
+	data[20] = byte(uint64(a[2]) >> 46)
+	data[21] = byte(uint64(a[2])>>54) | byte(uint64(a[3])<<3)
+	data[22] = byte(uint64(a[3]) >> 5)
+	data[23] = byte(uint64(a[3]) >> 13)
+	data[24] = byte(uint64(a[3]) >> 21)
+	data[25] = byte(uint64(a[3]) >> 29)
+	data[26] = byte(uint64(a[3]) >> 37)
+	data[27] = byte(uint64(a[3]) >> 45)
+	data[28] = byte(uint64(a[3])>>53) | byte(uint64(a[4])<<4)
+	data[29] = byte(uint64(a[4]) >> 4)
+	data[30] = byte(uint64(a[4]) >> 12)
+	data[31] = byte(uint64(a[4]) >> 20)
+	data[32] = byte(uint64(a[4]) >> 28)
+	data[33] = byte(uint64(a[4]) >> 36)
+	data[34] = byte(uint64(a[4]) >> 44)
+	data[35] = byte(uint64(a[4])>>52) | byte(uint64(a[5])<<5)
+	data[36] = byte(uint64(a[5]) >> 3)
+	data[37] = byte(uint64(a[5]) >> 11)
+	data[38] = byte(uint64(a[5]) >> 19)
+	data[39] = byte(uint64(a[5]) >> 27)
+	data[40] = byte(uint64(a[5]) >> 35)
+	data[41] = byte(uint64(a[5]) >> 43)
+	data[42] = byte(uint64(a[5])>>51) | byte(uint64(a[6])<<6)
+	data[43] = byte(uint64(a[6]) >> 2)
+	data[44] = byte(uint64(a[6]) >> 10)
+	data[45] = byte(uint64(a[6]) >> 18)
+	data[46] = byte(uint64(a[6]) >> 26)
+	data[47] = byte(uint64(a[6]) >> 34)
+	data[48] = byte(uint64(a[6]) >> 42)
+	data[49] = byte(uint64(a[6])>>50) | byte(uint64(a[7])<<7)
+	data[50] = byte(uint64(a[7]) >> 1)
+	data[51] = byte(uint64(a[7]) >> 9)
+	data[52] = byte(uint64(a[7]) >> 17)
+	data[53] = byte(uint64(a[7]) >> 25)
+	data[54] = byte(uint64(a[7]) >> 33)
+	data[55] = byte(uint64(a[7]) >> 41)
+	data[56] = byte(uint64(a[7]) >> 49)
+}
+
+func pack8int64_58(data []byte, a [8]int64) {
+	_ = data[57]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<2)
+	data[8] = byte(uint64(a[1]) >> 6)
+	data[9] = byte(uint64(a[1]) >> 14)
+	data[10] = byte(uint64(a[1]) >> 22)
+	data[11] = byte(uint64(a[1]) >> 30)
+	data[12] = byte(uint64(a[1]) >> 38)
+	data[13] = byte(uint64(a[1]) >> 46)
+	data[14] = byte(uint64(a[1])>>54) | byte(uint64(a[2])<<4)
+	data[15] = byte(uint64(a[2]) >> 4)
+	data[16] = byte(uint64(a[2]) >> 12)
+	data[17] = byte(uint64(a[2]) >> 20)
+	data[18] = byte(uint64(a[2]) >> 28)
+	data[19] = byte(uint64(a[2]) >> 36)
+	data[20] = byte(uint64(a[2]) >> 44)
+	data[21] = byte(uint64(a[2])>>52) | byte(uint64(a[3])<<6)
+	data[22] = byte(uint64(a[3]) >> 2)
+	data[23] = byte(uint64(a[3]) >> 10)
+	data[24] = byte(uint64(a[3]) >> 18)
+	data[25] = byte(uint64(a[3]) >> 26)
+	data[26] = byte(uint64(a[3]) >> 34)
+	data[27] = byte(uint64(a[3]) >> 42)
+	data[28] = byte(uint64(a[3]) >> 50)
+	data[29] = byte(uint64(a[4]) << 0)
+	data[30] = byte(uint64(a[4]) >> 8)
+	data[31] = byte(uint64(a[4]) >> 16)
+	data[32] = byte(uint64(a[4]) >> 24)
+	data[33] = byte(uint64(a[4]) >> 32)
+	data[34] = byte(uint64(a[4]) >> 40)
+	data[35] = byte(uint64(a[4]) >> 48)
+	data[36] = byte(uint64(a[4])>>56) | byte(uint64(a[5])<<2)
+	data[37] = byte(uint64(a[5]) >> 6)
+	data[38] = byte(uint64(a[5]) >> 14)
+	data[39] = byte(uint64(a[5]) >> 22)
+	data[40] = byte(uint64(a[5]) >> 30)
+	data[41] = byte(uint64(a[5]) >> 38)
+	data[42] = byte(uint64(a[5]) >> 46)
+	data[43] = byte(uint64(a[5])>>54) | byte(uint64(a[6])<<4)
+	data[44] = byte(uint64(a[6]) >> 4)
+	data[45] = byte(uint64(a[6]) >> 12)
+	data[46] = byte(uint64(a[6]) >> 20)
+	data[47] = byte(uint64(a[6]) >> 28)
+	data[48] = byte(uint64(a[6]) >> 36)
+	data[49] = byte(uint64(a[6]) >> 44)
+	data[50] = byte(uint64(a[6])>>52) | byte(uint64(a[7])<<6)
+	data[51] = byte(uint64(a[7]) >> 2)
+	data[52] = byte(uint64(a[7]) >> 10)
+	data[53] = byte(uint64(a[7]) >> 18)
+	data[54] = byte(uint64(a[7]) >> 26)
+	data[55] = byte(uint64(a[7]) >> 34)
+	data[56] = byte(uint64(a[7]) >> 42)
+	data[57] = byte(uint64(a[7]) >> 50)
+}
+
+func pack8int64_59(data []byte, a [8]int64) {
+	_ = data[58]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<3)
+	data[8] = byte(uint64(a[1]) >> 5)
+	data[9] = byte(uint64(a[1]) >> 13)
+	data[10] = byte(uint64(a[1]) >> 21)
+	data[11] = byte(uint64(a[1]) >> 29)
+	data[12] = byte(uint64(a[1]) >> 37)
+	data[13] = byte(uint64(a[1]) >> 45)
+	data[14] = byte(uint64(a[1])>>53) | byte(uint64(a[2])<<6)
+	data[15] = byte(uint64(a[2]) >> 2)
+	data[16] = byte(uint64(a[2]) >> 10)
+	data[17] = byte(uint64(a[2]) >> 18)
+	data[18] = byte(uint64(a[2]) >> 26)
+	data[19] = byte(uint64(a[2]) >> 34)
+	data[20] = byte(uint64(a[2]) >> 42)
+	data[21] = byte(uint64(a[2]) >> 50)
+	data[22] = byte(uint64(a[2])>>58) | byte(uint64(a[3])<<1)
+	data[23] = byte(uint64(a[3]) >> 7)
+	data[24] = byte(uint64(a[3]) >> 15)
+	data[25] = byte(uint64(a[3]) >> 23)
+	data[26] = byte(uint64(a[3]) >> 31)
+	data[27] = byte(uint64(a[3]) >> 39)
+	data[28] = byte(uint64(a[3]) >> 47)
+	data[29] = byte(uint64(a[3])>>55) | byte(uint64(a[4])<<4)
+	data[30] = byte(uint64(a[4]) >> 4)
+	data[31] = byte(uint64(a[4]) >> 12)
+	data[32] = byte(uint64(a[4]) >> 20)
+	data[33] = byte(uint64(a[4]) >> 28)
+	data[34] = byte(uint64(a[4]) >> 36)
+	data[35] = byte(uint64(a[4]) >> 44)
+	data[36] = byte(uint64(a[4])>>52) | byte(uint64(a[5])<<7)
+	data[37] = byte(uint64(a[5]) >> 1)
+	data[38] = byte(uint64(a[5]) >> 9)
+	data[39] = byte(uint64(a[5]) >> 17)
+	data[40] = byte(uint64(a[5]) >> 25)
+	data[41] = byte(uint64(a[5]) >> 33)
+	data[42] = byte(uint64(a[5]) >> 41)
+	data[43] = byte(uint64(a[5]) >> 49)
+	data[44] = byte(uint64(a[5])>>57) | byte(uint64(a[6])<<2)
+	data[45] = byte(uint64(a[6]) >> 6)
+	data[46] = byte(uint64(a[6]) >> 14)
+	data[47] = byte(uint64(a[6]) >> 22)
+	data[48] = byte(uint64(a[6]) >> 30)
+	data[49] = byte(uint64(a[6]) >> 38)
+	data[50] = byte(uint64(a[6]) >> 46)
+	data[51] = byte(uint64(a[6])>>54) | byte(uint64(a[7])<<5)
+	data[52] = byte(uint64(a[7]) >> 3)
+	data[53] = byte(uint64(a[7]) >> 11)
+	data[54] = byte(uint64(a[7]) >> 19)
+	data[55] = byte(uint64(a[7]) >> 27)
+	data[56] = byte(uint64(a[7]) >> 35)
+	data[57] = byte(uint64(a[7]) >> 43)
+	data[58] = byte(uint64(a[7]) >> 51)
+}
+
+func pack8int64_60(data []byte, a [8]int64) {
+	_ = data[59]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<4)
+	data[8] = byte(uint64(a[1]) >> 4)
+	data[9] = byte(uint64(a[1]) >> 12)
+	data[10] = byte(uint64(a[1]) >> 20)
+	data[11] = byte(uint64(a[1]) >> 28)
+	data[12] = byte(uint64(a[1]) >> 36)
+	data[13] = byte(uint64(a[1]) >> 44)
+	data[14] = byte(uint64(a[1]) >> 52)
+	data[15] = byte(uint64(a[2]) << 0)
+	data[16] = byte(uint64(a[2]) >> 8)
+	data[17] = byte(uint64(a[2]) >> 16)
+	data[18] = byte(uint64(a[2]) >> 24)
+	data[19] = byte(uint64(a[2]) >> 32)
+	data[20] = byte(uint64(a[2]) >> 40)
+	data[21] = byte(uint64(a[2]) >> 48)
+	data[22] = byte(uint64(a[2])>>56) | byte(uint64(a[3])<<4)
+	data[23] = byte(uint64(a[3]) >> 4)
+	data[24] = byte(uint64(a[3]) >> 12)
+	data[25] = byte(uint64(a[3]) >> 20)
+	data[26] = byte(uint64(a[3]) >> 28)
+	data[27] = byte(uint64(a[3]) >> 36)
+	data[28] = byte(uint64(a[3]) >> 44)
+	data[29] = byte(uint64(a[3]) >> 52)
+	data[30] = byte(uint64(a[4]) << 0)
+	data[31] = byte(uint64(a[4]) >> 8)
+	data[32] = byte(uint64(a[4]) >> 16)
+	data[33] = byte(uint64(a[4]) >> 24)
+	data[34] = byte(uint64(a[4]) >> 32)
+	data[35] = byte(uint64(a[4]) >> 40)
+	data[36] = byte(uint64(a[4]) >> 48)
+	data[37] = byte(uint64(a[4])>>56) | byte(uint64(a[5])<<4)
+	data[38] = byte(uint64(a[5]) >> 4)
+	data[39] = byte(uint64(a[5]) >> 12)
+	data[40] = byte(uint64(a[5]) >> 20)
+	data[41] = byte(uint64(a[5]) >> 28)
+	data[42] = byte(uint64(a[5]) >> 36)
+	data[43] = byte(uint64(a[5]) >> 44)
+	data[44] = byte(uint64(a[5]) >> 52)
+	data[45] = byte(uint64(a[6]) << 0)
+	data[46] = byte(uint64(a[6]) >> 8)
+	data[47] = byte(uint64(a[6]) >> 16)
+	data[48] = byte(uint64(a[6]) >> 24)
+	data[49] = byte(uint64(a[6]) >> 32)
+	data[50] = byte(uint64(a[6]) >> 40)
+	data[51] = byte(uint64(a[6]) >> 48)
+	data[52] = byte(uint64(a[6])>>56) | byte(uint64(a[7])<<4)
+	data[53] = byte(uint64(a[7]) >> 4)
+	data[54] = byte(uint64(a[7]) >> 12)
+	data[55] = byte(uint64(a[7]) >> 20)
+	data[56] = byte(uint64(a[7]) >> 28)
+	data[57] = byte(uint64(a[7]) >> 36)
+	data[58] = byte(uint64(a[7]) >> 44)
+	data[59] = byte(uint64(a[7]) >> 52)
+}
+
+func pack8int64_61(data []byte, a [8]int64) {
+	_ = data[60]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<5)
+	data[8] = byte(uint64(a[1]) >> 3)
+	data[9] = byte(uint64(a[1]) >> 11)
+	data[10] = byte(uint64(a[1]) >> 19)
+	data[11] = byte(uint64(a[1]) >> 27)
+	data[12] = byte(uint64(a[1]) >> 35)
+	data[13] = byte(uint64(a[1]) >> 43)
+	data[14] = byte(uint64(a[1]) >> 51)
+	data[15] = byte(uint64(a[1])>>59) | byte(uint64(a[2])<<2)
+	data[16] = byte(uint64(a[2]) >> 6)
+	data[17] = byte(uint64(a[2]) >> 14)
+	data[18] = byte(uint64(a[2]) >> 22)
+	data[19] = byte(uint64(a[2]) >> 30)
+	data[20] = byte(uint64(a[2]) >> 38)
+	data[21] = byte(uint64(a[2]) >> 46)
+	data[22] = byte(uint64(a[2])>>54) | byte(uint64(a[3])<<7)
+	data[23] = byte(uint64(a[3]) >> 1)
+	data[24] = byte(uint64(a[3]) >> 9)
+	data[25] = byte(uint64(a[3]) >> 17)
+	data[26] = byte(uint64(a[3]) >> 25)
+	data[27] = byte(uint64(a[3]) >> 33)
+	data[28] = byte(uint64(a[3]) >> 41)
+	data[29] = byte(uint64(a[3]) >> 49)
+	data[30] = byte(uint64(a[3])>>57) | byte(uint64(a[4])<<4)
+	data[31] = byte(uint64(a[4]) >> 4)
+	data[32] = byte(uint64(a[4]) >> 12)
+	data[33] = byte(uint64(a[4]) >> 20)
+	data[34] = byte(uint64(a[4]) >> 28)
+	data[35] = byte(uint64(a[4]) >> 36)
+	data[36] = byte(uint64(a[4]) >> 44)
+	data[37] = byte(uint64(a[4]) >> 52)
+	data[38] = byte(uint64(a[4])>>60) | byte(uint64(a[5])<<1)
+	data[39] = byte(uint64(a[5]) >> 7)
+	data[40] = byte(uint64(a[5]) >> 15)
+	data[41] = byte(uint64(a[5]) >> 23)
+	data[42] = byte(uint64(a[5]) >> 31)
+	data[43] = byte(uint64(a[5]) >> 39)
+	data[44] = byte(uint64(a[5]) >> 47)
+	data[45] = byte(uint64(a[5])>>55) | byte(uint64(a[6])<<6)
+	data[46] = byte(uint64(a[6]) >> 2)
+	data[47] = byte(uint64(a[6]) >> 10)
+	data[48] = byte(uint64(a[6]) >> 18)
+	data[49] = byte(uint64(a[6]) >> 26)
+	data[50] = byte(uint64(a[6]) >> 34)
+	data[51] = byte(uint64(a[6]) >> 42)
+	data[52] = byte(uint64(a[6]) >> 50)
+	data[53] = byte(uint64(a[6])>>58) | byte(uint64(a[7])<<3)
+	data[54] = byte(uint64(a[7]) >> 5)
+	data[55] = byte(uint64(a[7]) >> 13)
+	data[56] = byte(uint64(a[7]) >> 21)
+	data[57] = byte(uint64(a[7]) >> 29)
+	data[58] = byte(uint64(a[7]) >> 37)
+	data[59] = byte(uint64(a[7]) >> 45)
+	data[60] = byte(uint64(a[7]) >> 53)
+}
+
+func pack8int64_62(data []byte, a [8]int64) {
+	_ = data[61]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<6)
+	data[8] = byte(uint64(a[1]) >> 2)
+	data[9] = byte(uint64(a[1]) >> 10)
+	data[10] = byte(uint64(a[1]) >> 18)
+	data[11] = byte(uint64(a[1]) >> 26)
+	data[12] = byte(uint64(a[1]) >> 34)
+	data[13] = byte(uint64(a[1]) >> 42)
+	data[14] = byte(uint64(a[1]) >> 50)
+	data[15] = byte(uint64(a[1])>>58) | byte(uint64(a[2])<<4)
+	data[16] = byte(uint64(a[2]) >> 4)
+	data[17] = byte(uint64(a[2]) >> 12)
+	data[18] = byte(uint64(a[2]) >> 20)
+	data[19] = byte(uint64(a[2]) >> 28)
+	data[20] = byte(uint64(a[2]) >> 36)
+	data[21] = byte(uint64(a[2]) >> 44)
+	data[22] = byte(uint64(a[2]) >> 52)
+	data[23] = byte(uint64(a[2])>>60) | byte(uint64(a[3])<<2)
+	data[24] = byte(uint64(a[3]) >> 6)
+	data[25] = byte(uint64(a[3]) >> 14)
+	data[26] = byte(uint64(a[3]) >> 22)
+	data[27] = byte(uint64(a[3]) >> 30)
+	data[28] = byte(uint64(a[3]) >> 38)
+	data[29] = byte(uint64(a[3]) >> 46)
+	data[30] = byte(uint64(a[3]) >> 54)
+	data[31] = byte(uint64(a[4]) << 0)
+	data[32] = byte(uint64(a[4]) >> 8)
+	data[33] = byte(uint64(a[4]) >> 16)
+	data[34] = byte(uint64(a[4]) >> 24)
+	data[35] = byte(uint64(a[4]) >> 32)
+	data[36] = byte(uint64(a[4]) >> 40)
+	data[37] = byte(uint64(a[4]) >> 48)
+	data[38] = byte(uint64(a[4])>>56) | byte(uint64(a[5])<<6)
+	data[39] = byte(uint64(a[5]) >> 2)
+	data[40] = byte(uint64(a[5]) >> 10)
+	data[41] = byte(uint64(a[5]) >> 18)
+	data[42] = byte(uint64(a[5]) >> 26)
+	data[43] = byte(uint64(a[5]) >> 34)
+	data[44] = byte(uint64(a[5]) >> 42)
+	data[45] = byte(uint64(a[5]) >> 50)
+	data[46] = byte(uint64(a[5])>>58) | byte(uint64(a[6])<<4)
+	data[47] = byte(uint64(a[6]) >> 4)
+	data[48] = byte(uint64(a[6]) >> 12)
+	data[49] = byte(uint64(a[6]) >> 20)
+	data[50] = byte(uint64(a[6]) >> 28)
+	data[51] = byte(uint64(a[6]) >> 36)
+	data[52] = byte(uint64(a[6]) >> 44)
+	data[53] = byte(uint64(a[6]) >> 52)
+	data[54] = byte(uint64(a[6])>>60) | byte(uint64(a[7])<<2)
+	data[55] = byte(uint64(a[7]) >> 6)
+	data[56] = byte(uint64(a[7]) >> 14)
+	data[57] = byte(uint64(a[7]) >> 22)
+	data[58] = byte(uint64(a[7]) >> 30)
+	data[59] = byte(uint64(a[7]) >> 38)
+	data[60] = byte(uint64(a[7]) >> 46)
+	data[61] = byte(uint64(a[7]) >> 54)
+}
+
+func pack8int64_63(data []byte, a [8]int64) {
+	_ = data[62]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0])>>56) | byte(uint64(a[1])<<7)
+	data[8] = byte(uint64(a[1]) >> 1)
+	data[9] = byte(uint64(a[1]) >> 9)
+	data[10] = byte(uint64(a[1]) >> 17)
+	data[11] = byte(uint64(a[1]) >> 25)
+	data[12] = byte(uint64(a[1]) >> 33)
+	data[13] = byte(uint64(a[1]) >> 41)
+	data[14] = byte(uint64(a[1]) >> 49)
+	data[15] = byte(uint64(a[1])>>57) | byte(uint64(a[2])<<6)
+	data[16] = byte(uint64(a[2]) >> 2)
+	data[17] = byte(uint64(a[2]) >> 10)
+	data[18] = byte(uint64(a[2]) >> 18)
+	data[19] = byte(uint64(a[2]) >> 26)
+	data[20] = byte(uint64(a[2]) >> 34)
+	data[21] = byte(uint64(a[2]) >> 42)
+	data[22] = byte(uint64(a[2]) >> 50)
+	data[23] = byte(uint64(a[2])>>58) | byte(uint64(a[3])<<5)
+	data[24] = byte(uint64(a[3]) >> 3)
+	data[25] = byte(uint64(a[3]) >> 11)
+	data[26] = byte(uint64(a[3]) >> 19)
+	data[27] = byte(uint64(a[3]) >> 27)
+	data[28] = byte(uint64(a[3]) >> 35)
+	data[29] = byte(uint64(a[3]) >> 43)
+	data[30] = byte(uint64(a[3]) >> 51)
+	data[31] = byte(uint64(a[3])>>59) | byte(uint64(a[4])<<4)
+	data[32] = byte(uint64(a[4]) >> 4)
+	data[33] = byte(uint64(a[4]) >> 12)
+	data[34] = byte(uint64(a[4]) >> 20)
+	data[35] = byte(uint64(a[4]) >> 28)
+	data[36] = byte(uint64(a[4]) >> 36)
+	data[37] = byte(uint64(a[4]) >> 44)
+	data[38] = byte(uint64(a[4]) >> 52)
+	data[39] = byte(uint64(a[4])>>60) | byte(uint64(a[5])<<3)
+	data[40] = byte(uint64(a[5]) >> 5)
+	data[41] = byte(uint64(a[5]) >> 13)
+	data[42] = byte(uint64(a[5]) >> 21)
+	data[43] = byte(uint64(a[5]) >> 29)
+	data[44] = byte(uint64(a[5]) >> 37)
+	data[45] = byte(uint64(a[5]) >> 45)
+	data[46] = byte(uint64(a[5]) >> 53)
+	data[47] = byte(uint64(a[5])>>61) | byte(uint64(a[6])<<2)
+	data[48] = byte(uint64(a[6]) >> 6)
+	data[49] = byte(uint64(a[6]) >> 14)
+	data[50] = byte(uint64(a[6]) >> 22)
+	data[51] = byte(uint64(a[6]) >> 30)
+	data[52] = byte(uint64(a[6]) >> 38)
+	data[53] = byte(uint64(a[6]) >> 46)
+	data[54] = byte(uint64(a[6]) >> 54)
+	data[55] = byte(uint64(a[6])>>62) | byte(uint64(a[7])<<1)
+	data[56] = byte(uint64(a[7]) >> 7)
+	data[57] = byte(uint64(a[7]) >> 15)
+	data[58] = byte(uint64(a[7]) >> 23)
+	data[59] = byte(uint64(a[7]) >> 31)
+	data[60] = byte(uint64(a[7]) >> 39)
+	data[61] = byte(uint64(a[7]) >> 47)
+	data[62] = byte(uint64(a[7]) >> 55)
+}
+
+func pack8int64_64(data []byte, a [8]int64) {
+	_ = data[63]
+	data[0] = byte(uint64(a[0]) << 0)
+	data[1] = byte(uint64(a[0]) >> 8)
+	data[2] = byte(uint64(a[0]) >> 16)
+	data[3] = byte(uint64(a[0]) >> 24)
+	data[4] = byte(uint64(a[0]) >> 32)
+	data[5] = byte(uint64(a[0]) >> 40)
+	data[6] = byte(uint64(a[0]) >> 48)
+	data[7] = byte(uint64(a[0]) >> 56)
+	data[8] = byte(uint64(a[1]) << 0)
+	data[9] = byte(uint64(a[1]) >> 8)
+	data[10] = byte(uint64(a[1]) >> 16)
+	data[11] = byte(uint64(a[1]) >> 24)
+	data[12] = byte(uint64(a[1]) >> 32)
+	data[13] = byte(uint64(a[1]) >> 40)
+	data[14] = byte(uint64(a[1]) >> 48)
+	data[15] = byte(uint64(a[1]) >> 56)
+	data[16] = byte(uint64(a[2]) << 0)
+	data[17] = byte(uint64(a[2]) >> 8)
+	data[18] = byte(uint64(a[2]) >> 16)
+	data[19] = byte(uint64(a[2]) >> 24)
+	data[20] = byte(uint64(a[2]) >> 32)
+	data[21] = byte(uint64(a[2]) >> 40)
+	data[22] = byte(uint64(a[2]) >> 48)
+	data[23] = byte(uint64(a[2]) >> 56)
+	data[24] = byte(uint64(a[3]) << 0)
+	data[25] = byte(uint64(a[3]) >> 8)
+	data[26] = byte(uint64(a[3]) >> 16)
+	data[27] = byte(uint64(a[3]) >> 24)
+	data[28] = byte(uint64(a[3]) >> 32)
+	data[29] = byte(uint64(a[3]) >> 40)
+	data[30] = byte(uint64(a[3]) >> 48)
+	data[31] = byte(uint64(a[3]) >> 56)
+	data[32] = byte(uint64(a[4]) << 0)
+	data[33] = byte(uint64(a[4]) >> 8)
+	data[34] = byte(uint64(a[4]) >> 16)
+	data[35] = byte(uint64(a[4]) >> 24)
+	data[36] = byte(uint64(a[4]) >> 32)
+	data[37] = byte(uint64(a[4]) >> 40)
+	data[38] = byte(uint64(a[4]) >> 48)
+	data[39] = byte(uint64(a[4]) >> 56)
+	data[40] = byte(uint64(a[5]) << 0)
+	data[41] = byte(uint64(a[5]) >> 8)
+	data[42] = byte(uint64(a[5]) >> 16)
+	data[43] = byte(uint64(a[5]) >> 24)
+	data[44] = byte(uint64(a[5]) >> 32)
+	data[45] = byte(uint64(a[5]) >> 40)
+	data[46] = byte(uint64(a[5]) >> 48)
+	data[47] = byte(uint64(a[5]) >> 56)
+	data[48] = byte(uint64(a[6]) << 0)
+	data[49] = byte(uint64(a[6]) >> 8)
+	data[50] = byte(uint64(a[6]) >> 16)
+	data[51] = byte(uint64(a[6]) >> 24)
+	data[52] = byte(uint64(a[6]) >> 32)
+	data[53] = byte(uint64(a[6]) >> 40)
+	data[54] = byte(uint64(a[6]) >> 48)
+	data[55] = byte(uint64(a[6]) >> 56)
+	data[56] = byte(uint64(a[7]) << 0)
+	data[57] = byte(uint64(a[7]) >> 8)
+	data[58] = byte(uint64(a[7]) >> 16)
+	data[59] = byte(uint64(a[7]) >> 24)
+	data[60] = byte(uint64(a[7]) >> 32)
+	data[61] = byte(uint64(a[7]) >> 40)
+	data[62] = byte(uint64(a[7]) >> 48)
+	data[63] = byte(uint64(a[7]) >> 56)
+}
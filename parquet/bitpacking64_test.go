@@ -1,6 +1,10 @@
 package parquet
 
-import "testing"
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
 
 func TestUnpack8int64(t *testing.T) {
 	for _, test := range unpack8int64Tests {
@@ -11,6 +15,31 @@ func TestUnpack8int64(t *testing.T) {
 	}
 }
 
+func TestPack8int64(t *testing.T) {
+	for _, test := range unpack8int64Tests {
+		data := make([]byte, test.width)
+		pack8Int64FuncByWidth[test.width](data, test.values)
+		if !bytes.Equal(data, test.data) {
+			t.Errorf("pack for width %d: got %v, want %v", test.width, data, test.data)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for w := 1; w <= 64; w++ {
+		for n := 0; n < 100; n++ {
+			var values [8]int64
+			for i := range values {
+				values[i] = int64(r.Uint64() >> uint(64-w))
+			}
+			data := make([]byte, w)
+			pack8Int64FuncByWidth[w](data, values)
+			if got := unpack8Int64FuncByWidth[w](data); got != values {
+				t.Fatalf("width %d: got %v, want %v", w, got, values)
+			}
+		}
+	}
+}
+
 var unpack8int64Tests = []struct {
 	width  int
 	data   []byte
@@ -53,6 +53,30 @@ func genFunc(out io.Writer, maxWidth int, bw int) {
 	fmt.Fprintf(out, "}\n\n")
 }
 
+func genPackFunc(out io.Writer, maxWidth int, bw int) {
+	fmt.Fprintf(out, "func pack8int%d_%d(data []byte, a [8]int%d) {\n", maxWidth, bw, maxWidth)
+	fmt.Fprintf(out, "\t_ = data[%d]\n", bw-1)
+	for b := 0; b < bw; b++ {
+		var expr string
+		for i := 0; i < 8; i++ {
+			start := i * bw
+			if start+bw <= 8*b || start >= 8*b+8 {
+				continue
+			}
+			if len(expr) != 0 {
+				expr += " | "
+			}
+			if start >= 8*b {
+				expr += fmt.Sprintf("byte(uint%d(a[%d]) << %d)", maxWidth, i, start-8*b)
+			} else {
+				expr += fmt.Sprintf("byte(uint%d(a[%d]) >> %d)", maxWidth, i, 8*b-start)
+			}
+		}
+		fmt.Fprintf(out, "\tdata[%d] = %s\n", b, expr)
+	}
+	fmt.Fprintf(out, "}\n\n")
+}
+
 func genPackage(fn string, maxWidth int) {
 	buf := new(bytes.Buffer)
 
@@ -61,6 +85,9 @@ func genPackage(fn string, maxWidth int) {
 	for i := 1; i <= maxWidth; i++ {
 		genFunc(buf, maxWidth, i)
 	}
+	for i := 1; i <= maxWidth; i++ {
+		genPackFunc(buf, maxWidth, i)
+	}
 
 	src, err := format.Source(buf.Bytes())
 	if err != nil {
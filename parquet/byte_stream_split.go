@@ -0,0 +1,76 @@
+package parquet
+
+import "errors"
+
+// byteStreamSplitDecoder decodes fixed width values encoded with
+// BYTE_STREAM_SPLIT encoding. The data consists of width streams, the k-th
+// stream contains the k-th bytes of all values.
+type byteStreamSplitDecoder struct {
+	width int
+
+	data []byte
+	n    int // number of values
+	i    int // index of the next value
+}
+
+func (d *byteStreamSplitDecoder) init(data []byte) error {
+	if d.width <= 0 {
+		return errors.New("byte_stream_split: invalid value size")
+	}
+	if len(data)%d.width != 0 {
+		return errors.New("byte_stream_split: data size is not a multiple of value size")
+	}
+	d.data = data
+	d.n = len(data) / d.width
+	d.i = 0
+	return nil
+}
+
+// next copies bytes of the next value into b (len(b) = width).
+func (d *byteStreamSplitDecoder) next(b []byte) error {
+	if d.i >= d.n {
+		return errNED
+	}
+	for k := range b {
+		b[k] = d.data[k*d.n+d.i]
+	}
+	d.i++
+	return nil
+}
+
+func (d *byteStreamSplitDecoder) skip(n int) error {
+	if n > d.n-d.i {
+		return errNED
+	}
+	d.i += n
+	return nil
+}
+
+// byteStreamSplitEncoder encodes fixed width values with BYTE_STREAM_SPLIT
+// encoding. Values are accumulated in little-endian (PLAIN) form and split
+// into streams in flush.
+type byteStreamSplitEncoder struct {
+	width int
+
+	values []byte
+	data   []byte
+}
+
+func (e *byteStreamSplitEncoder) size() int {
+	return len(e.values)
+}
+
+func (e *byteStreamSplitEncoder) flush() []byte {
+	n := len(e.values) / e.width
+	if cap(e.data) < len(e.values) {
+		e.data = make([]byte, len(e.values))
+	}
+	e.data = e.data[:len(e.values)]
+	for i := 0; i < n; i++ {
+		for k := 0; k < e.width; k++ {
+			e.data[k*n+i] = e.values[i*e.width+k]
+		}
+	}
+	e.values = e.values[:0]
+	return e.data
+}
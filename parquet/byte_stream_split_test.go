@@ -0,0 +1,175 @@
+package parquet
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func TestFloatByteStreamSplitDecoder(t *testing.T) {
+	testValuesDecoder(t, newFloatByteStreamSplitDecoder(), []decoderTestCase{
+		{
+			data: []byte{
+				0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00,
+				0x80, 0x80, 0x80,
+				0x3F, 0xBF, 0x7F,
+			},
+			decoded: []interface{}{
+				float32(1.0),
+				float32(-1.0),
+				float32(math.Inf(+1)),
+			},
+		},
+	})
+}
+
+func TestByteArrayByteStreamSplitDecoder(t *testing.T) {
+	testValuesDecoder(t, newByteArrayByteStreamSplitDecoder(3), []decoderTestCase{
+		{
+			data: []byte{
+				0x01, 0x04,
+				0x02, 0x05,
+				0x03, 0x06,
+			},
+			decoded: []interface{}{
+				[]byte{1, 2, 3},
+				[]byte{4, 5, 6},
+			},
+		},
+	})
+}
+
+func TestByteStreamSplitDecoderErrors(t *testing.T) {
+	if err := newDoubleByteStreamSplitDecoder().init(make([]byte, 12)); err == nil {
+		t.Errorf("error expected for data size that is not a multiple of 8")
+	}
+	if err := newByteArrayByteStreamSplitDecoder(0).init(nil); err == nil {
+		t.Errorf("error expected for zero length values")
+	}
+}
+
+func TestByteStreamSplitRoundTrip(t *testing.T) {
+	tests := []struct {
+		e      valuesEncoder
+		d      valuesDecoder
+		values interface{}
+	}{
+		{newFloatByteStreamSplitEncoder(), newFloatByteStreamSplitDecoder(), []float32{0, 1.5, -2, float32(math.Inf(-1))}},
+		{newDoubleByteStreamSplitEncoder(), newDoubleByteStreamSplitDecoder(), []float64{0, 1.5, -2, math.MaxFloat64}},
+		{newInt32ByteStreamSplitEncoder(), newInt32ByteStreamSplitDecoder(), []int32{0, 1, -1, math.MinInt32, math.MaxInt32}},
+		{newInt64ByteStreamSplitEncoder(), newInt64ByteStreamSplitDecoder(), []int64{0, 1, -1, math.MinInt64, math.MaxInt64}},
+		{newByteArrayByteStreamSplitEncoder(2), newByteArrayByteStreamSplitDecoder(2), [][]byte{{1, 2}, {3, 4}, {5, 6}}},
+	}
+	for _, test := range tests {
+		if err := test.e.encode(test.values); err != nil {
+			t.Errorf("%T: failed to encode: %s", test.e, err)
+			continue
+		}
+		if err := test.d.init(test.e.flush()); err != nil {
+			t.Errorf("%T: failed to init: %s", test.d, err)
+			continue
+		}
+		rv := reflect.ValueOf(test.values)
+		decoded := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len()).Interface()
+		if err := test.d.decode(decoded); err != nil {
+			t.Errorf("%T: failed to decode: %s", test.d, err)
+			continue
+		}
+		if !reflect.DeepEqual(decoded, test.values) {
+			t.Errorf("%T: got %v, want %v", test.d, decoded, test.values)
+		}
+	}
+
+	if err := newByteArrayByteStreamSplitEncoder(2).encode([][]byte{{1}}); err == nil {
+		t.Errorf("error expected for a value of invalid length")
+	}
+}
+
+func TestWriteByteStreamSplit(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{
+			Name:        "Test",
+			NumChildren: int32Ptr(2),
+		},
+		&pf.SchemaElement{
+			Name:           "f",
+			Type:           typeFloat,
+			RepetitionType: frtOptional,
+		},
+		&pf.SchemaElement{
+			Name:           "i",
+			Type:           typeInt64,
+			RepetitionType: frtRequired,
+		},
+	))
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 2
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	data := [][]cell{
+		{{1, 0, float32(0.5)}, {0, 0, nil}, {1, 0, float32(-3)}, {1, 0, float32(7)}, {0, 0, nil}},
+		{{0, 0, int64(10)}, {0, 0, int64(-20)}, {0, 0, int64(30)}, {0, 0, int64(1 << 40)}, {0, 0, int64(0)}},
+	}
+	for _, col := range schema.Columns() {
+		cw, err := fw.NewWriter(col)
+		if err != nil {
+			t.Fatalf("failed to create column chunk writer: %s", err)
+		}
+		if err = cw.SetEncoding(pf.Encoding_BYTE_STREAM_SPLIT); err != nil {
+			t.Fatalf("failed to set encoding: %s", err)
+		}
+		var values []interface{}
+		var dLevels, rLevels []uint16
+		for _, c := range data[col.Index()] {
+			if c.v != nil {
+				values = append(values, c.v)
+			}
+			dLevels = append(dLevels, c.d)
+			rLevels = append(rLevels, c.r)
+		}
+		if err = cw.Write(values, dLevels, rLevels); err != nil {
+			t.Fatalf("failed to write column %s: %s", col, err)
+		}
+		if err = cw.Close(); err != nil {
+			t.Fatalf("failed to close column chunk writer: %s", err)
+		}
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	for _, col := range schema.Columns() {
+		if encodings := f.MetaData.RowGroups[0].Columns[col.Index()].MetaData.Encodings; !containsEncoding(encodings, pf.Encoding_BYTE_STREAM_SPLIT) {
+			t.Errorf("column %s: got encodings %v", col, encodings)
+		}
+		cells, err := readCells(f, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", col, err)
+		}
+		if !reflect.DeepEqual(cells, data[col.Index()]) {
+			t.Errorf("column %s: got %v, want %v", col, cells, data[col.Index()])
+		}
+	}
+}
+
+func containsEncoding(encodings []pf.Encoding, e pf.Encoding) bool {
+	for _, enc := range encodings {
+		if enc == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,398 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/kostya-sh/parquet-go/parquetformat"
+	"github.com/pierrec/lz4/v4"
+	lzo "github.com/rasky/go-lzo"
+)
+
+// Codec compresses and decompresses page data. Implementations must be safe
+// for concurrent use.
+type Codec interface {
+	// Decode decompresses src. The decompressed data is appended to dst
+	// (which can be nil) and the resulting slice is returned.
+	// uncompressedSize is the size of the decompressed data according to
+	// the page header.
+	Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error)
+
+	// Encode compresses src. The compressed data is appended to dst (which
+	// can be nil) and the resulting slice is returned.
+	Encode(dst []byte, src []byte) ([]byte, error)
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[parquetformat.CompressionCodec]Codec{}
+)
+
+// RegisterCodec makes c available for reading and writing data compressed
+// with the compression codec cc. It replaces a previously registered Codec
+// (including a built-in one) for cc.
+//
+// Codecs for UNCOMPRESSED, SNAPPY, GZIP, ZSTD, LZ4, LZ4_RAW, BROTLI and LZO
+// are registered by default.
+func RegisterCodec(cc parquetformat.CompressionCodec, c Codec) {
+	if c == nil {
+		panic("parquet: RegisterCodec with nil Codec")
+	}
+	codecsMu.Lock()
+	codecs[cc] = c
+	codecsMu.Unlock()
+}
+
+func init() {
+	RegisterCodec(parquetformat.CompressionCodec_UNCOMPRESSED, uncompressedCodec{})
+	RegisterCodec(parquetformat.CompressionCodec_SNAPPY, snappyCodec{})
+	RegisterCodec(parquetformat.CompressionCodec_GZIP, gzipCodec{})
+	RegisterCodec(parquetformat.CompressionCodec_ZSTD, zstdCodec{})
+	RegisterCodec(parquetformat.CompressionCodec_LZ4, hadoopCodec{raw: lz4RawCodec{}})
+	RegisterCodec(parquetformat.CompressionCodec_LZ4_RAW, lz4RawCodec{})
+	RegisterCodec(parquetformat.CompressionCodec_BROTLI, brotliCodec{})
+	RegisterCodec(parquetformat.CompressionCodec_LZO, hadoopCodec{raw: lzoRawCodec{}})
+}
+
+func lookupCodec(cc parquetformat.CompressionCodec) (Codec, error) {
+	codecsMu.RLock()
+	c, ok := codecs[cc]
+	codecsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported compression codec: %s", cc)
+	}
+	return c, nil
+}
+
+func checkCompressionCodec(cc parquetformat.CompressionCodec) error {
+	_, err := lookupCodec(cc)
+	return err
+}
+
+// compressPageData compresses page data using codec cc.
+func compressPageData(cc parquetformat.CompressionCodec, data []byte) ([]byte, error) {
+	c, err := lookupCodec(cc)
+	if err != nil {
+		return nil, err
+	}
+	return c.Encode(nil, data)
+}
+
+// decompressPageData decompresses page data using codec cc.
+func decompressPageData(cc parquetformat.CompressionCodec, data []byte, uncompressedSize int) ([]byte, error) {
+	c, err := lookupCodec(cc)
+	if err != nil {
+		return nil, err
+	}
+	return c.Decode(nil, data, uncompressedSize)
+}
+
+type uncompressedCodec struct{}
+
+func (uncompressedCodec) Decode(dst []byte, src []byte, _ int) ([]byte, error) {
+	if dst == nil {
+		return src, nil
+	}
+	return append(dst, src...), nil
+}
+
+func (uncompressedCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	if dst == nil {
+		return src, nil
+	}
+	return append(dst, src...), nil
+}
+
+// snappyCodec uses snappy block format (not the framing format)
+type snappyCodec struct{}
+
+func (snappyCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	n, err := snappy.DecodedLen(src)
+	if err != nil {
+		return nil, err
+	}
+	if n > uncompressedSize {
+		return nil, fmt.Errorf("snappy: decoded size %d is larger than %d", n, uncompressedSize)
+	}
+	buf := grow(dst, n)
+	out, err := snappy.Decode(buf[len(dst):len(dst)+n], src)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:len(dst)+len(out)], nil
+}
+
+func (snappyCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	return append(dst, snappy.Encode(nil, src)...), nil
+}
+
+type gzipCodec struct{}
+
+func (gzipCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	return readDecoded(dst, src, r, uncompressedSize)
+}
+
+func (gzipCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// zstd encoder is safe for concurrent use and is created on first use
+var (
+	zstdOnce    sync.Once
+	zstdErr     error
+	zstdEncoder *zstd.Encoder
+)
+
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+	})
+	return zstdErr
+}
+
+// zstdDecoders is a pool of synchronous stream decoders. Data is decoded as a
+// stream so that decoding can be stopped as soon as the uncompressed size is
+// exceeded.
+var zstdDecoders sync.Pool
+
+type zstdCodec struct{}
+
+func (zstdCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	d, ok := zstdDecoders.Get().(*zstd.Decoder)
+	if !ok {
+		var err error
+		if d, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1)); err != nil {
+			return nil, err
+		}
+	}
+	defer zstdDecoders.Put(d)
+	if err := d.Reset(bytes.NewReader(src)); err != nil {
+		return nil, err
+	}
+	return readDecoded(dst, src, d, uncompressedSize)
+}
+
+func (zstdCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	if err := initZstd(); err != nil {
+		return nil, err
+	}
+	return zstdEncoder.EncodeAll(src, dst), nil
+}
+
+// lz4MaxRatio is the upper bound of the LZ4 compression ratio (every byte of
+// a match length can add at most 255 bytes).
+const lz4MaxRatio = 255
+
+// lz4RawCodec uses LZ4 block format without any framing.
+type lz4RawCodec struct{}
+
+func (lz4RawCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	// the block is decoded into a buffer of a fixed size, the buffer is
+	// doubled (up to uncompressedSize) if it is too short
+	buf := growDecoded(dst, src, uncompressedSize)
+	for {
+		size := cap(buf) - len(dst)
+		if size > uncompressedSize {
+			size = uncompressedSize
+		}
+		n, err := lz4.UncompressBlock(src, buf[len(dst):len(dst)+size])
+		if err == nil {
+			return buf[:len(dst)+n], nil
+		}
+		if size >= uncompressedSize || size >= lz4MaxRatio*len(src) {
+			return nil, err
+		}
+		buf = grow(dst, 2*size)
+	}
+}
+
+func (lz4RawCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	bound := lz4.CompressBlockBound(len(src))
+	buf := grow(dst, bound)
+	n, err := lz4.CompressBlock(src, buf[len(dst):len(dst)+bound], nil)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:len(dst)+n], nil
+}
+
+// brotliCodec uses brotli stream format.
+type brotliCodec struct{}
+
+func (brotliCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	return readDecoded(dst, src, brotli.NewReader(bytes.NewReader(src)), uncompressedSize)
+}
+
+func (brotliCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	w := brotli.NewWriter(buf)
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// lzoRawCodec uses LZO1X format without any framing.
+type lzoRawCodec struct{}
+
+func (lzoRawCodec) Decode(dst []byte, src []byte, uncompressedSize int) (out []byte, err error) {
+	// the decompressor doesn't check all bounds and can panic on invalid
+	// input
+	defer func() {
+		if r := recover(); r != nil {
+			out, err = nil, fmt.Errorf("lzo: invalid compressed data: %v", r)
+		}
+	}()
+	b, err := lzo.Decompress1X(bytes.NewReader(src), len(src), uncompressedSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > uncompressedSize {
+		return nil, fmt.Errorf("lzo: decompressed size %d is larger than %d", len(b), uncompressedSize)
+	}
+	return append(dst, b...), nil
+}
+
+func (lzoRawCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	return append(dst, lzo.Compress1X(src)...), nil
+}
+
+// hadoopCodec uses the framing of Hadoop's BlockCompressorStream that is used
+// by Hadoop's Lz4Codec and hadoop-lzo's LzoCodec (the deprecated LZ4 and LZO
+// parquet codecs). Data is split into blocks, every block starts with the
+// big-endian 32-bit uncompressed size of the block followed by one or more
+// chunks compressed with raw. Every chunk is prefixed with its big-endian
+// 32-bit compressed size.
+//
+// Some writers (e.g. older versions of parquet-cpp) use these codecs without
+// framing. Like parquet-mr and Arrow data that cannot be decoded as Hadoop
+// framed data is decoded with raw directly.
+type hadoopCodec struct {
+	raw Codec
+}
+
+var errInvalidHadoopFraming = errors.New("invalid Hadoop framing")
+
+func (c hadoopCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	if out, err := c.decodeFramed(dst, src, uncompressedSize); err == nil {
+		return out, nil
+	}
+	return c.raw.Decode(dst, src, uncompressedSize)
+}
+
+func (c hadoopCodec) decodeFramed(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	out := dst
+	end := len(dst) + uncompressedSize
+	for len(src) > 0 {
+		if len(src) < 4 {
+			return nil, errInvalidHadoopFraming
+		}
+		blockSize := int64(binary.BigEndian.Uint32(src))
+		src = src[4:]
+		if blockSize > int64(end-len(out)) {
+			return nil, errInvalidHadoopFraming
+		}
+		blockEnd := len(out) + int(blockSize)
+		// every block has at least one chunk (Arrow writes a chunk for
+		// an empty block)
+		for first := true; first || len(out) < blockEnd; first = false {
+			if len(src) < 4 {
+				return nil, errInvalidHadoopFraming
+			}
+			chunkSize := int64(binary.BigEndian.Uint32(src))
+			src = src[4:]
+			if chunkSize > int64(len(src)) {
+				return nil, errInvalidHadoopFraming
+			}
+			var err error
+			out, err = c.raw.Decode(out, src[:chunkSize], blockEnd-len(out))
+			if err != nil || len(out) > blockEnd {
+				return nil, errInvalidHadoopFraming
+			}
+			src = src[chunkSize:]
+		}
+	}
+	if len(out) != end {
+		return nil, errInvalidHadoopFraming
+	}
+	return out, nil
+}
+
+// Encode writes src as a single block with a single chunk (the same as
+// Arrow).
+func (c hadoopCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	var header [8]byte
+	out, err := c.raw.Encode(append(dst, header[:]...), src)
+	if err != nil {
+		return nil, err
+	}
+	h := out[len(dst):]
+	binary.BigEndian.PutUint32(h, uint32(len(src)))
+	binary.BigEndian.PutUint32(h[4:], uint32(len(out)-len(dst)-8))
+	return out, nil
+}
+
+// maxDecodedPrealloc limits the capacity preallocated for decompressed data to
+// a multiple of the compressed size.
+const maxDecodedPrealloc = 4
+
+// growDecoded returns dst with capacity for uncompressedSize more bytes, but
+// no more than maxDecodedPrealloc times len(src). uncompressedSize comes from
+// a page header and cannot be trusted, the buffer grows while decoding if
+// the data is compressed better.
+func growDecoded(dst []byte, src []byte, uncompressedSize int) []byte {
+	if max := maxDecodedPrealloc * len(src); uncompressedSize > max {
+		uncompressedSize = max
+	}
+	return grow(dst, uncompressedSize)
+}
+
+// readDecoded appends data decompressed by r to dst. uncompressedSize comes
+// from a page header, an error is returned as soon as r produces more data.
+func readDecoded(dst []byte, src []byte, r io.Reader, uncompressedSize int) ([]byte, error) {
+	buf := bytes.NewBuffer(growDecoded(dst, src, uncompressedSize))
+	n, err := io.Copy(buf, io.LimitReader(r, int64(uncompressedSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > int64(uncompressedSize) {
+		return nil, fmt.Errorf("decompressed size is larger than %d", uncompressedSize)
+	}
+	return buf.Bytes(), nil
+}
+
+// grow returns b with capacity for at least n more bytes.
+func grow(b []byte, n int) []byte {
+	if n < 0 {
+		n = 0
+	}
+	if cap(b)-len(b) >= n {
+		return b
+	}
+	nb := make([]byte, len(b), len(b)+n)
+	copy(nb, b)
+	return nb
+}
@@ -0,0 +1,248 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+var codecTestData = [][]byte{
+	nil,
+	[]byte("a"),
+	bytes.Repeat([]byte("parquet"), 1000),
+}
+
+func testCodecRoundTrip(t *testing.T, cc pf.CompressionCodec) {
+	for i, data := range codecTestData {
+		compressed, err := compressPageData(cc, data)
+		if err != nil {
+			t.Errorf("%s: test %d: failed to compress: %s", cc, i, err)
+			continue
+		}
+		got, err := decompressPageData(cc, compressed, len(data))
+		if err != nil {
+			t.Errorf("%s: test %d: failed to decompress: %s", cc, i, err)
+			continue
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s: test %d: got %d bytes after round trip, want %d", cc, i, len(got), len(data))
+		}
+	}
+}
+
+func TestCodecs(t *testing.T) {
+	for _, cc := range []pf.CompressionCodec{
+		pf.CompressionCodec_UNCOMPRESSED,
+		pf.CompressionCodec_SNAPPY,
+		pf.CompressionCodec_GZIP,
+		pf.CompressionCodec_ZSTD,
+		pf.CompressionCodec_LZ4,
+		pf.CompressionCodec_LZ4_RAW,
+		pf.CompressionCodec_BROTLI,
+		pf.CompressionCodec_LZO,
+	} {
+		testCodecRoundTrip(t, cc)
+	}
+}
+
+func TestDecodeLargeUncompressedSize(t *testing.T) {
+	data := make([]byte, 100000)
+	for _, cc := range []pf.CompressionCodec{
+		pf.CompressionCodec_GZIP,
+		pf.CompressionCodec_ZSTD,
+		pf.CompressionCodec_LZ4_RAW,
+		pf.CompressionCodec_BROTLI,
+	} {
+		compressed, err := compressPageData(cc, data)
+		if err != nil {
+			t.Errorf("%s: failed to compress: %s", cc, err)
+			continue
+		}
+		// the uncompressed size from a page header is not used to
+		// preallocate the buffer
+		got, err := decompressPageData(cc, compressed, 1<<30)
+		if err != nil {
+			t.Errorf("%s: failed to decompress: %s", cc, err)
+			continue
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s: got %d bytes after round trip, want %d", cc, len(got), len(data))
+		}
+		if cap(got) > 4*len(data) {
+			t.Errorf("%s: got buffer of capacity %d for %d bytes", cc, cap(got), len(data))
+		}
+	}
+
+	compressed, err := compressPageData(pf.CompressionCodec_LZ4_RAW, data)
+	if err != nil {
+		t.Fatalf("failed to compress: %s", err)
+	}
+	if _, err = decompressPageData(pf.CompressionCodec_LZ4_RAW, compressed[:len(compressed)-1], 1<<30); err == nil {
+		t.Errorf("error expected for truncated LZ4 data")
+	}
+}
+
+func TestDecodeSizeLimit(t *testing.T) {
+	data := make([]byte, 100000)
+	for _, cc := range []pf.CompressionCodec{
+		pf.CompressionCodec_SNAPPY,
+		pf.CompressionCodec_GZIP,
+		pf.CompressionCodec_ZSTD,
+		pf.CompressionCodec_BROTLI,
+	} {
+		compressed, err := compressPageData(cc, data)
+		if err != nil {
+			t.Errorf("%s: failed to compress: %s", cc, err)
+			continue
+		}
+		// decompression stops when the uncompressed size is exceeded
+		if _, err = decompressPageData(cc, compressed, len(data)-1); err == nil {
+			t.Errorf("%s: error expected for data larger than the uncompressed size", cc)
+		}
+		if _, err = decompressPageData(cc, compressed, len(data)); err != nil {
+			t.Errorf("%s: failed to decompress: %s", cc, err)
+		}
+	}
+}
+
+func TestHadoopFraming(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	for cc, rawCC := range map[pf.CompressionCodec]pf.CompressionCodec{
+		pf.CompressionCodec_LZ4: pf.CompressionCodec_LZ4_RAW,
+		pf.CompressionCodec_LZO: -1,
+	} {
+		c, err := lookupCodec(cc)
+		if err != nil {
+			t.Fatalf("%s: %s", cc, err)
+		}
+		raw := c.(hadoopCodec).raw
+
+		unframed, err := raw.Encode(nil, data)
+		if err != nil {
+			t.Fatalf("%s: failed to compress: %s", cc, err)
+		}
+
+		// two blocks with a single chunk each
+		var blocks []byte
+		for _, part := range [][]byte{data[:300], data[300:]} {
+			if blocks, err = c.Encode(blocks, part); err != nil {
+				t.Fatalf("%s: failed to compress: %s", cc, err)
+			}
+		}
+
+		// a single block with two chunks
+		chunks := make([]byte, 4)
+		binary.BigEndian.PutUint32(chunks, uint32(len(data)))
+		for _, part := range [][]byte{data[:300], data[300:]} {
+			chunk, err := raw.Encode(nil, part)
+			if err != nil {
+				t.Fatalf("%s: failed to compress: %s", cc, err)
+			}
+			var size [4]byte
+			binary.BigEndian.PutUint32(size[:], uint32(len(chunk)))
+			chunks = append(append(chunks, size[:]...), chunk...)
+		}
+
+		for name, compressed := range map[string][]byte{"blocks": blocks, "chunks": chunks, "unframed": unframed} {
+			got, err := decompressPageData(cc, compressed, len(data))
+			if err != nil {
+				t.Errorf("%s: %s: failed to decompress: %s", cc, name, err)
+				continue
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("%s: %s: got %q, want %q", cc, name, got, data)
+			}
+		}
+
+		if rawCC >= 0 {
+			if _, err = decompressPageData(rawCC, blocks, len(data)); err == nil {
+				t.Errorf("%s: error expected when decoding Hadoop framed data", rawCC)
+			}
+		}
+	}
+}
+
+func TestInvalidLZO(t *testing.T) {
+	for _, data := range [][]byte{{}, {0x11}, {0x20, 0xff, 0xff}, {0x00, 0x00, 0x00, 0x00, 0x11, 0x00}} {
+		if _, err := decompressPageData(pf.CompressionCodec_LZO, data, 10); err == nil {
+			t.Errorf("error expected for %v", data)
+		}
+	}
+}
+
+func TestWriteCompressedFile(t *testing.T) {
+	f, err := OpenFile("testdata/ByteArrays.parquet")
+	if err != nil {
+		t.Fatalf("failed to open file: %s", err)
+	}
+	defer f.Close()
+
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, f.Schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.Compression = pf.CompressionCodec_ZSTD
+	if err = copyFile(f, fw, 3); err != nil {
+		t.Fatalf("copy failed: %s", err)
+	}
+
+	copied, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read copied file: %s", err)
+	}
+	for _, col := range f.Schema.Columns() {
+		if codec := copied.MetaData.RowGroups[0].Columns[col.Index()].MetaData.Codec; codec != pf.CompressionCodec_ZSTD {
+			t.Errorf("column %s: got codec %s, want ZSTD", col, codec)
+		}
+		want, err := readCells(f, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", col, err)
+		}
+		got, err := readCells(copied, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read copied column %s: %s", col, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("column %s: got %v, want %v", col, got, want)
+		}
+	}
+
+	if _, err = decompressPageData(pf.CompressionCodec(100), nil, 0); err == nil {
+		t.Errorf("error expected for an unsupported codec")
+	}
+}
+
+// countingCodec counts calls of the wrapped codec.
+type countingCodec struct {
+	Codec
+	decoded, encoded int
+}
+
+func (c *countingCodec) Decode(dst []byte, src []byte, uncompressedSize int) ([]byte, error) {
+	c.decoded++
+	return c.Codec.Decode(dst, src, uncompressedSize)
+}
+
+func (c *countingCodec) Encode(dst []byte, src []byte) ([]byte, error) {
+	c.encoded++
+	return c.Codec.Encode(dst, src)
+}
+
+func TestRegisterCodec(t *testing.T) {
+	gzip, err := lookupCodec(pf.CompressionCodec_GZIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &countingCodec{Codec: gzip}
+	RegisterCodec(pf.CompressionCodec_GZIP, c)
+	defer RegisterCodec(pf.CompressionCodec_GZIP, gzip)
+
+	testCodecRoundTrip(t, pf.CompressionCodec_GZIP)
+	if n := len(codecTestData); c.encoded != n || c.decoded != n {
+		t.Errorf("got %d Encode and %d Decode calls, want %d", c.encoded, c.decoded, n)
+	}
+}
@@ -0,0 +1,429 @@
+package parquet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+const (
+	metadataFileName       = "_metadata"
+	commonMetadataFileName = "_common_metadata"
+)
+
+// Dataset is a logical table stored in multiple parquet files with the same
+// schema, e.g. a directory with one file per partition. Row groups of all
+// files are numbered sequentially (in order of files) and can be read with
+// Dataset.NewReader the same way as row groups of a single File.
+//
+// Directories of a dataset named key=value (Hive style partitioning, e.g.
+// year=2024/month=05/part-0.parquet) define values of partition columns for
+// all rows of the files they contain. Partition columns are not stored in the
+// files, they follow the columns of Schema in Columns and their values can be
+// obtained with PartitionValue.
+//
+// Files of a dataset are kept open until the dataset is closed.
+type Dataset struct {
+	Schema Schema
+
+	files            []*File
+	rowGroups        []datasetRowGroup
+	partitionColumns []Column
+}
+
+type datasetRowGroup struct {
+	file *File
+	rg   int
+
+	// path of the data file relative to the dataset directory (using "/"
+	// as a separator)
+	path string
+
+	// values of partition columns
+	partition []interface{}
+}
+
+// OpenDataset opens a dataset stored in directory dir.
+//
+// If dir contains a _metadata file then the dataset consists of row groups
+// listed in this file (the row groups can be stored in other files, see
+// File.OpenChunkFile). Otherwise all files in dir and its subdirectories are
+// read in lexical order, files and directories with names starting with "_"
+// or "." are ignored. If there is a _common_metadata file its schema is used
+// as the schema of the dataset.
+//
+// Partition columns are discovered from paths of the data files relative to
+// dir. All files must have the same partition keys. The type of a partition
+// column is INT64 if all its values are integers, DOUBLE if all values are
+// numbers and BYTE_ARRAY (UTF8) otherwise. __HIVE_DEFAULT_PARTITION__ is
+// the null value.
+func OpenDataset(dir string) (*Dataset, error) {
+	return OpenDatasetFilter(dir, nil)
+}
+
+// OpenDatasetFilter opens a dataset stored in directory dir (see OpenDataset)
+// skipping files (or row groups listed in _metadata) with values of partition
+// columns that don't match filter. Conditions on other columns are ignored,
+// use FilterRowGroups to skip row groups using their statistics. Skipped files
+// are not opened.
+//
+// If all files are skipped and there is no _common_metadata file, the schema
+// of the dataset is empty.
+func OpenDatasetFilter(dir string, filter Filter) (*Dataset, error) {
+	f, err := OpenFile(filepath.Join(dir, metadataFileName))
+	if err == nil {
+		d, err := openMetadataDataset(f, filter)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return d, nil
+	}
+	if _, serr := os.Stat(filepath.Join(dir, metadataFileName)); !os.IsNotExist(serr) {
+		return nil, err
+	}
+
+	var paths, relPaths []string
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		if name := info.Name(); strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.IsDir() {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, path)
+			relPaths = append(relPaths, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parquet: failed to list dataset files: %s", err)
+	}
+
+	var schema *Schema
+	common, err := OpenFile(filepath.Join(dir, commonMetadataFileName))
+	if err == nil {
+		schema = &common.Schema
+		if err = common.Close(); err != nil {
+			return nil, err
+		}
+	} else if _, serr := os.Stat(filepath.Join(dir, commonMetadataFileName)); !os.IsNotExist(serr) {
+		return nil, err
+	}
+
+	p, err := discoverPartitions(relPaths)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: %s", err)
+	}
+	var (
+		kept   []string
+		values [][]interface{}
+	)
+	for i, path := range paths {
+		ok, err := partitionMayMatch(p.columns, p.values[i], filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			kept = append(kept, path)
+			values = append(values, p.values[i])
+		}
+	}
+	if len(kept) == 0 && len(paths) > 0 {
+		d := &Dataset{}
+		if schema != nil {
+			d.Schema = *schema
+		}
+		if err = d.setPartitionColumns(p.columns); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+
+	d, err := openDataset(dir, kept, schema)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.setPartitionColumns(p.columns); err != nil {
+		d.Close()
+		return nil, err
+	}
+	fileIndex := make(map[*File]int)
+	for i, f := range d.files {
+		fileIndex[f] = i
+	}
+	for i := range d.rowGroups {
+		d.rowGroups[i].partition = values[fileIndex[d.rowGroups[i].file]]
+	}
+	return d, nil
+}
+
+// openMetadataDataset creates a dataset from row groups listed in a _metadata
+// file f that match filter.
+func openMetadataDataset(f *File, filter Filter) (*Dataset, error) {
+	paths := make([]string, len(f.MetaData.RowGroups))
+	for rg, rowGroup := range f.MetaData.RowGroups {
+		if len(rowGroup.Columns) > 0 && rowGroup.Columns[0].FilePath != nil {
+			paths[rg] = *rowGroup.Columns[0].FilePath
+		}
+	}
+	p, err := discoverPartitions(paths)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: %s", err)
+	}
+
+	d := &Dataset{Schema: f.Schema, files: []*File{f}}
+	if err = d.setPartitionColumns(p.columns); err != nil {
+		return nil, err
+	}
+	for rg, path := range paths {
+		ok, err := partitionMayMatch(p.columns, p.values[rg], filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			d.rowGroups = append(d.rowGroups, datasetRowGroup{file: f, rg: rg, path: path, partition: p.values[rg]})
+		}
+	}
+	return d, nil
+}
+
+// partitionMayMatch returns false if partition columns cols with values
+// don't match filter. filter can be nil.
+func partitionMayMatch(cols []Column, values []interface{}, filter Filter) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+	return filter.mayMatch(partitionStatsFunc(cols, values, unknownStats))
+}
+
+// setPartitionColumns sets partition columns of d numbering them after the
+// columns of d.Schema.
+func (d *Dataset) setPartitionColumns(cols []Column) error {
+	n := len(d.Schema.Columns())
+	for i := range cols {
+		if _, found := d.Schema.ColumnByName(cols[i].name); found {
+			return fmt.Errorf("parquet: partition column %s conflicts with a column of the schema", cols[i].name)
+		}
+		cols[i].index = n + i
+	}
+	d.partitionColumns = cols
+	return nil
+}
+
+// OpenDatasetFiles opens a dataset that consists of the given parquet files
+// (row groups are numbered in order of paths). All files must have compatible
+// schemas. Partition columns are not discovered.
+func OpenDatasetFiles(paths ...string) (*Dataset, error) {
+	return openDataset("", paths, nil)
+}
+
+// openDataset opens files at paths. Paths of row groups are relative to dir
+// (if dir is not empty). If schema is nil then the schema of the first file
+// is used as the schema of the dataset.
+func openDataset(dir string, paths []string, schema *Schema) (*Dataset, error) {
+	d := &Dataset{}
+	for _, path := range paths {
+		f, err := OpenFile(path)
+		if err != nil {
+			d.Close()
+			return nil, fmt.Errorf("parquet: failed to open dataset file %s: %s", path, err)
+		}
+		d.files = append(d.files, f)
+		if schema == nil {
+			schema = &f.Schema
+		}
+		if err = checkSchemaCompatible(*schema, f.Schema); err != nil {
+			d.Close()
+			return nil, fmt.Errorf("parquet: %s: incompatible schema: %s", path, err)
+		}
+
+		relPath := path
+		if dir != "" {
+			if rel, err := filepath.Rel(dir, path); err == nil {
+				relPath = rel
+			}
+		}
+		relPath = filepath.ToSlash(relPath)
+		for rg := range f.MetaData.RowGroups {
+			d.rowGroups = append(d.rowGroups, datasetRowGroup{file: f, rg: rg, path: relPath})
+		}
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("parquet: no files in dataset")
+	}
+	d.Schema = *schema
+	return d, nil
+}
+
+// checkSchemaCompatible returns an error if data of a file with schema s
+// cannot be read using columns of the dataset schema ds. Columns of both
+// schemas must have the same paths, physical types and maximum levels.
+// Logical types and other annotations are not compared.
+func checkSchemaCompatible(ds Schema, s Schema) error {
+	dcols, cols := ds.Columns(), s.Columns()
+	if len(dcols) != len(cols) {
+		return fmt.Errorf("%d columns, expected %d", len(cols), len(dcols))
+	}
+	for i, dcol := range dcols {
+		col := cols[i]
+		switch {
+		case col.name != dcol.name:
+			return fmt.Errorf("column %d is %s, expected %s", i, col, dcol)
+		case col.Type() != dcol.Type():
+			return fmt.Errorf("column %s has type %s, expected %s", col, col.Type(), dcol.Type())
+		case col.maxD != dcol.maxD || col.maxR != dcol.maxR:
+			return fmt.Errorf("column %s has different repetition of elements", col)
+		case col.Type() == parquetformat.Type_FIXED_LEN_BYTE_ARRAY &&
+			*col.schemaElement.TypeLength != *dcol.schemaElement.TypeLength:
+			return fmt.Errorf("column %s has length %d, expected %d", col,
+				*col.schemaElement.TypeLength, *dcol.schemaElement.TypeLength)
+		}
+	}
+	return nil
+}
+
+// NumRowGroups returns the number of row groups in all files of d.
+func (d *Dataset) NumRowGroups() int {
+	return len(d.rowGroups)
+}
+
+// NumRows returns the number of rows in all files of d.
+func (d *Dataset) NumRows() int64 {
+	var n int64
+	for i := range d.rowGroups {
+		n += d.RowGroup(i).NumRows
+	}
+	return n
+}
+
+// RowGroup returns metadata of row group rg.
+func (d *Dataset) RowGroup(rg int) *parquetformat.RowGroup {
+	r := d.rowGroups[rg]
+	return r.file.MetaData.RowGroups[r.rg]
+}
+
+// RowGroupFile returns the file that stores row group rg and the index of the
+// row group in this file.
+func (d *Dataset) RowGroupFile(rg int) (*File, int) {
+	r := d.rowGroups[rg]
+	return r.file, r.rg
+}
+
+// RowGroupPath returns the path of the file that contains data of row group rg
+// relative to the dataset directory (using "/" as a separator). For datasets
+// created with OpenDatasetFiles the path is the same as passed to
+// OpenDatasetFiles.
+func (d *Dataset) RowGroupPath(rg int) string {
+	return d.rowGroups[rg].path
+}
+
+// Columns returns columns of d.Schema followed by partition columns.
+func (d *Dataset) Columns() []Column {
+	cols := append([]Column{}, d.Schema.Columns()...)
+	return append(cols, d.partitionColumns...)
+}
+
+// PartitionColumns returns partition columns of d (in order of directories).
+func (d *Dataset) PartitionColumns() []Column {
+	return d.partitionColumns
+}
+
+// ColumnByName returns a column of d.Schema or a partition column by name.
+func (d *Dataset) ColumnByName(name string) (col Column, found bool) {
+	if col, found = d.Schema.ColumnByName(name); found {
+		return col, true
+	}
+	for _, col := range d.partitionColumns {
+		if col.name == name {
+			return col, true
+		}
+	}
+	return Column{}, false
+}
+
+// PartitionValue returns the value of partition column col for all rows of
+// row group rg: int64, float64, []byte or nil (for the null partition).
+func (d *Dataset) PartitionValue(rg int, col Column) interface{} {
+	i := col.Index() - len(d.Schema.Columns())
+	if i < 0 || i >= len(d.partitionColumns) {
+		return nil
+	}
+	return d.rowGroups[rg].partition[i]
+}
+
+// FilterRowGroups returns indexes of row groups of d that may contain rows
+// matching filter. Row groups are skipped using values of partition columns
+// and statistics of column chunks.
+func (d *Dataset) FilterRowGroups(filter Filter) ([]int, error) {
+	var rgs []int
+	for rg := range d.rowGroups {
+		ok, err := d.RowGroupMayMatch(rg, filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rgs = append(rgs, rg)
+		}
+	}
+	return rgs, nil
+}
+
+// RowGroupMayMatch returns false if it is known from values of partition
+// columns or statistics of column chunks that row group rg doesn't contain
+// rows matching filter.
+func (d *Dataset) RowGroupMayMatch(rg int, filter Filter) (bool, error) {
+	if rg < 0 || rg >= len(d.rowGroups) {
+		return false, fmt.Errorf("parquet: no such row group: %d", rg)
+	}
+	r := d.rowGroups[rg]
+	ok, err := filter.mayMatch(partitionStatsFunc(d.partitionColumns, r.partition, func(name string) (*valueStats, error) {
+		col, found := d.Schema.ColumnByName(name)
+		if !found {
+			return nil, fmt.Errorf("parquet: column %s not found", name)
+		}
+		return r.file.columnChunkStats(r.file.Schema.Columns()[col.Index()], r.rg), nil
+	}))
+	return ok && d.RowGroup(rg).NumRows != 0, err
+}
+
+// NewReader creates a ColumnChunkReader for reading a single column chunk for
+// column col (a column of d.Schema) from a row group rg. Partition columns
+// cannot be read, use PartitionValue.
+func (d *Dataset) NewReader(col Column, rg int) (*ColumnChunkReader, error) {
+	if rg < 0 || rg >= len(d.rowGroups) {
+		return nil, fmt.Errorf("no such rowgroup: %d", rg)
+	}
+	r := d.rowGroups[rg]
+	cols := r.file.Schema.Columns()
+	if col.Index() >= len(cols) {
+		return nil, fmt.Errorf("parquet: column %s does not belong to the dataset schema", col)
+	}
+	return r.file.NewReader(cols[col.Index()], r.rg)
+}
+
+// Close closes all files of d.
+func (d *Dataset) Close() error {
+	var err error
+	for _, f := range d.files {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	d.files = nil
+	return err
+}
@@ -0,0 +1,398 @@
+package parquet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func writeDatasetFile(t *testing.T, dir string, path string, data []byte) {
+	t.Helper()
+	path = filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeDatasetMetadata writes a metadata file at path with row groups of
+// parquet files stored in dir at paths.
+func writeDatasetMetadata(t *testing.T, dir string, path string, paths ...string) {
+	t.Helper()
+	var meta *pf.FileMetaData
+	for _, p := range paths {
+		p := p
+		f, err := OpenFile(filepath.Join(dir, filepath.FromSlash(p)))
+		if err != nil {
+			t.Fatalf("failed to open %s: %s", p, err)
+		}
+		f.Close()
+		for _, rg := range f.MetaData.RowGroups {
+			for _, chunk := range rg.Columns {
+				chunk.FilePath = &p
+			}
+		}
+		if meta == nil {
+			meta = f.MetaData
+		} else {
+			meta.RowGroups = append(meta.RowGroups, f.MetaData.RowGroups...)
+			meta.NumRows += f.MetaData.NumRows
+		}
+	}
+	buf := bytes.NewBuffer(append([]byte{}, magic...))
+	if err := WriteFileMetaData(buf, meta); err != nil {
+		t.Fatalf("failed to write metadata: %s", err)
+	}
+	writeDatasetFile(t, dir, path, buf.Bytes())
+}
+
+func createWriterTestFile(t *testing.T) []byte {
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, mustCreateSchema(writerTestMeta))
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	writeTestFile(t, fw, writerTestData)
+	return buf.Bytes()
+}
+
+func checkDataset(t *testing.T, d *Dataset, paths []string) {
+	t.Helper()
+	if n := d.NumRowGroups(); n != len(paths) {
+		t.Fatalf("got %d row groups, want %d", n, len(paths))
+	}
+	if n := d.NumRows(); n != int64(10*len(paths)) {
+		t.Errorf("got %d rows, want %d", n, 10*len(paths))
+	}
+	col, _ := d.Schema.ColumnByName("id")
+	for rg, path := range paths {
+		if p := d.RowGroupPath(rg); p != path {
+			t.Errorf("row group %d: got path %s, want %s", rg, p, path)
+		}
+		cr, err := d.NewReader(col, rg)
+		if err != nil {
+			t.Fatalf("row group %d: failed to create reader: %s", rg, err)
+		}
+		values, err := readAllInt64(cr)
+		if err != nil {
+			t.Fatalf("row group %d: failed to read: %s", rg, err)
+		}
+		if want := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(values, want) {
+			t.Errorf("row group %d: got %v, want %v", rg, values, want)
+		}
+	}
+	if _, err := d.NewReader(col, len(paths)); err == nil {
+		t.Errorf("error expected for a non-existent row group")
+	}
+}
+
+func TestOpenDataset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := createPageIndexTestFile(t)
+	paths := []string{"a/part-0.parquet", "b/part-0.parquet", "b/part-1.parquet"}
+	for _, path := range paths {
+		writeDatasetFile(t, dir, path, data)
+	}
+	writeDatasetFile(t, dir, "_SUCCESS", nil)
+	writeDatasetFile(t, dir, "b/.part-0.parquet.crc", []byte("crc"))
+	writeDatasetFile(t, dir, "_tmp/part-2.parquet", []byte("incomplete"))
+
+	d, err := OpenDataset(dir)
+	if err != nil {
+		t.Fatalf("failed to open dataset: %s", err)
+	}
+	checkDataset(t, d, paths)
+	if err = d.Close(); err != nil {
+		t.Errorf("failed to close dataset: %s", err)
+	}
+
+	d, err = OpenDatasetFiles(filepath.Join(dir, "b", "part-1.parquet"), filepath.Join(dir, "a", "part-0.parquet"))
+	if err != nil {
+		t.Fatalf("failed to open dataset files: %s", err)
+	}
+	checkDataset(t, d, []string{
+		filepath.ToSlash(filepath.Join(dir, "b", "part-1.parquet")),
+		filepath.ToSlash(filepath.Join(dir, "a", "part-0.parquet")),
+	})
+	d.Close()
+
+	// _metadata lists only some of the files
+	writeDatasetMetadata(t, dir, "_metadata", "b/part-1.parquet", "a/part-0.parquet")
+	d, err = OpenDataset(dir)
+	if err != nil {
+		t.Fatalf("failed to open dataset with _metadata: %s", err)
+	}
+	checkDataset(t, d, []string{"b/part-1.parquet", "a/part-0.parquet"})
+	d.Close()
+}
+
+func TestOpenDatasetIncompatibleSchemas(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeDatasetFile(t, dir, "part-0.parquet", createPageIndexTestFile(t))
+	writeDatasetFile(t, dir, "part-1.parquet", createWriterTestFile(t))
+	if _, err = OpenDataset(dir); err == nil {
+		t.Errorf("error expected for files with different schemas")
+	}
+
+	os.Remove(filepath.Join(dir, "part-1.parquet"))
+	d, err := OpenDataset(dir)
+	if err != nil {
+		t.Fatalf("failed to open dataset: %s", err)
+	}
+	d.Close()
+
+	// schema in _common_metadata is different
+	buf := bytes.NewBuffer(append([]byte{}, magic...))
+	if err = WriteFileMetaData(buf, writerTestMeta); err != nil {
+		t.Fatalf("failed to write metadata: %s", err)
+	}
+	writeDatasetFile(t, dir, "_common_metadata", buf.Bytes())
+	if _, err = OpenDataset(dir); err == nil {
+		t.Errorf("error expected for a file with schema different from _common_metadata")
+	}
+
+	if _, err = OpenDataset(filepath.Join(dir, "nonexistent")); err == nil {
+		t.Errorf("error expected for a non-existent directory")
+	}
+}
+
+func TestCheckSchemaCompatible(t *testing.T) {
+	base := []*pf.SchemaElement{
+		{Name: "Test", NumChildren: int32Ptr(2)},
+		{Name: "a", Type: typeInt64, RepetitionType: frtRequired},
+		{Name: "b", Type: typeByteArray, RepetitionType: frtOptional},
+	}
+	tests := []struct {
+		elems []*pf.SchemaElement
+		ok    bool
+	}{
+		{base, true},
+		{[]*pf.SchemaElement{
+			{Name: "Other", NumChildren: int32Ptr(2)},
+			{Name: "a", Type: typeInt64, RepetitionType: frtRequired},
+			{Name: "b", Type: typeByteArray, RepetitionType: frtOptional, ConvertedType: ctUTF8},
+		}, true},
+		{[]*pf.SchemaElement{
+			{Name: "Test", NumChildren: int32Ptr(2)},
+			{Name: "a", Type: typeInt32, RepetitionType: frtRequired},
+			{Name: "b", Type: typeByteArray, RepetitionType: frtOptional},
+		}, false},
+		{[]*pf.SchemaElement{
+			{Name: "Test", NumChildren: int32Ptr(2)},
+			{Name: "a", Type: typeInt64, RepetitionType: frtRequired},
+			{Name: "b", Type: typeByteArray, RepetitionType: frtRepeated},
+		}, false},
+		{[]*pf.SchemaElement{
+			{Name: "Test", NumChildren: int32Ptr(2)},
+			{Name: "b", Type: typeByteArray, RepetitionType: frtOptional},
+			{Name: "a", Type: typeInt64, RepetitionType: frtRequired},
+		}, false},
+		{[]*pf.SchemaElement{
+			{Name: "Test", NumChildren: int32Ptr(1)},
+			{Name: "a", Type: typeInt64, RepetitionType: frtRequired},
+		}, false},
+	}
+	ds := mustCreateSchema(createFileMetaData(base...))
+	for i, test := range tests {
+		err := checkSchemaCompatible(ds, mustCreateSchema(createFileMetaData(test.elems...)))
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("test %d: got error %v, want ok = %t", i, err, test.ok)
+		}
+	}
+}
+
+func TestDiscoverPartitions(t *testing.T) {
+	p, err := discoverPartitions([]string{
+		"a=1/b=x/c=1.5/d=%2F2/part-0.parquet",
+		"a=2/b=2/c=2/d=__HIVE_DEFAULT_PARTITION__/part-0.parquet",
+		"a=__HIVE_DEFAULT_PARTITION__/b=z/c=-3/d=x%3Dy/part-0.parquet",
+	})
+	if err != nil {
+		t.Fatalf("failed to discover partitions: %s", err)
+	}
+	wantTypes := []pf.Type{pf.Type_INT64, pf.Type_BYTE_ARRAY, pf.Type_DOUBLE, pf.Type_BYTE_ARRAY}
+	for i, col := range p.columns {
+		if col.Type() != wantTypes[i] || col.Index() != i || col.MaxD() != 1 {
+			t.Errorf("column %d: got %s (type %s, index %d, maxD %d)", i, col, col.Type(), col.Index(), col.MaxD())
+		}
+	}
+	wantValues := [][]interface{}{
+		{int64(1), []byte("x"), 1.5, []byte("/2")},
+		{int64(2), []byte("2"), 2.0, nil},
+		{nil, []byte("z"), -3.0, []byte("x=y")},
+	}
+	if !reflect.DeepEqual(p.values, wantValues) {
+		t.Errorf("got values %v, want %v", p.values, wantValues)
+	}
+
+	for _, paths := range [][]string{
+		{"a=1/part-0.parquet", "part-1.parquet"},
+		{"a=1/b=1/part-0.parquet", "b=1/a=1/part-1.parquet"},
+		{"a=1/a=2/part-0.parquet"},
+		{"a=%zz/part-0.parquet"},
+	} {
+		if _, err := discoverPartitions(paths); err == nil {
+			t.Errorf("%v: error expected", paths)
+		}
+	}
+}
+
+func checkPartitionValues(t *testing.T, d *Dataset, want [][]interface{}) {
+	t.Helper()
+	if n := d.NumRowGroups(); n != len(want) {
+		t.Fatalf("got %d row groups, want %d", n, len(want))
+	}
+	for rg, values := range want {
+		var got []interface{}
+		for _, col := range d.PartitionColumns() {
+			got = append(got, d.PartitionValue(rg, col))
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("row group %d (%s): got %v, want %v", rg, d.RowGroupPath(rg), got, values)
+		}
+	}
+}
+
+func TestOpenDatasetPartitions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := createPageIndexTestFile(t)
+	writeDatasetFile(t, dir, "year=2023/region=eu/part-0.parquet", data)
+	writeDatasetFile(t, dir, "year=2024/region=us/part-0.parquet", data)
+	writeDatasetFile(t, dir, "year=2024/region=__HIVE_DEFAULT_PARTITION__/part-0.parquet", data)
+
+	d, err := OpenDataset(dir)
+	if err != nil {
+		t.Fatalf("failed to open dataset: %s", err)
+	}
+	cols := d.Columns()
+	if len(cols) != 4 {
+		t.Fatalf("got %d columns, want 4", len(cols))
+	}
+	year, found := d.ColumnByName("year")
+	if !found || year.Index() != 2 || year.Type() != pf.Type_INT64 || cols[2].name != "year" {
+		t.Errorf("unexpected year column: %s (index %d, type %s)", year, year.Index(), year.Type())
+	}
+	region, found := d.ColumnByName("region")
+	if !found || region.Index() != 3 || region.Type() != pf.Type_BYTE_ARRAY || cols[3].name != "region" {
+		t.Errorf("unexpected region column: %s (index %d, type %s)", region, region.Index(), region.Type())
+	}
+	if len(d.Schema.Columns()) != 2 {
+		t.Errorf("partition columns must not be added to the schema")
+	}
+	checkPartitionValues(t, d, [][]interface{}{
+		{int64(2023), []byte("eu")},
+		{int64(2024), nil},
+		{int64(2024), []byte("us")},
+	})
+	if _, err = d.NewReader(year, 0); err == nil {
+		t.Errorf("error expected for reading a partition column")
+	}
+
+	filterTests := []struct {
+		filter Filter
+		want   []int
+	}{
+		{Eq("year", 2024), []int{1, 2}},
+		{Eq("region", "eu"), []int{0}},
+		{IsNull("region"), []int{1}},
+		{Not(IsNull("region")), []int{0, 2}},
+		{And(Ge("year", 2024), Eq("id", 5)), []int{1, 2}},
+		{Or(Lt("year", 2024), Gt("id", 100)), []int{0}},
+		{Gt("id", 100), nil},
+	}
+	for _, test := range filterTests {
+		rgs, err := d.FilterRowGroups(test.filter)
+		if err != nil {
+			t.Errorf("%v: %s", test.filter, err)
+		} else if !reflect.DeepEqual(rgs, test.want) {
+			t.Errorf("%v: got %v, want %v", test.filter, rgs, test.want)
+		}
+	}
+	if _, err = d.FilterRowGroups(Eq("year", "x")); err == nil {
+		t.Errorf("error expected for a value of a wrong type")
+	}
+	d.Close()
+
+	// pruned files are not opened
+	writeDatasetFile(t, dir, "year=2022/region=eu/part-0.parquet", []byte("not a parquet file"))
+	if _, err = OpenDataset(dir); err == nil {
+		t.Errorf("error expected for an invalid file")
+	}
+	d, err = OpenDatasetFilter(dir, And(Eq("year", 2024), Ne("id", 5)))
+	if err != nil {
+		t.Fatalf("failed to open dataset with filter: %s", err)
+	}
+	checkPartitionValues(t, d, [][]interface{}{
+		{int64(2024), nil},
+		{int64(2024), []byte("us")},
+	})
+	if p := d.RowGroupPath(1); p != "year=2024/region=us/part-0.parquet" {
+		t.Errorf("got path %s", p)
+	}
+	d.Close()
+
+	d, err = OpenDatasetFilter(dir, Gt("year", 2030))
+	if err != nil {
+		t.Fatalf("failed to open dataset with filter: %s", err)
+	}
+	if d.NumRowGroups() != 0 || len(d.PartitionColumns()) != 2 {
+		t.Errorf("got %d row groups and %d partition columns, want 0 and 2",
+			d.NumRowGroups(), len(d.PartitionColumns()))
+	}
+	d.Close()
+	os.RemoveAll(filepath.Join(dir, "year=2022"))
+
+	// _metadata
+	writeDatasetMetadata(t, dir, "_metadata",
+		"year=2023/region=eu/part-0.parquet", "year=2024/region=us/part-0.parquet")
+	d, err = OpenDatasetFilter(dir, Eq("region", "us"))
+	if err != nil {
+		t.Fatalf("failed to open dataset with _metadata: %s", err)
+	}
+	checkPartitionValues(t, d, [][]interface{}{{int64(2024), []byte("us")}})
+	checkDataset(t, d, []string{"year=2024/region=us/part-0.parquet"})
+	d.Close()
+}
+
+func TestOpenDatasetPartitionConflicts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := createPageIndexTestFile(t)
+	writeDatasetFile(t, dir, "id=1/part-0.parquet", data)
+	if _, err = OpenDataset(dir); err == nil {
+		t.Errorf("error expected for a partition column with the name of a schema column")
+	}
+	os.RemoveAll(filepath.Join(dir, "id=1"))
+
+	writeDatasetFile(t, dir, "a=1/part-0.parquet", data)
+	writeDatasetFile(t, dir, "b=1/part-0.parquet", data)
+	if _, err = OpenDataset(dir); err == nil {
+		t.Errorf("error expected for files with different partition keys")
+	}
+}
@@ -31,6 +31,14 @@ type valuesDecoder interface {
 	decode(dst interface{}) error
 }
 
+// valuesSkipper is implemented by values decoders that can skip values without
+// decoding them.
+type valuesSkipper interface {
+	// skip skips exactly n values. It should return errNED if there is not
+	// enough data to skip n values.
+	skip(n int) error
+}
+
 type dictValuesDecoder interface {
 	valuesDecoder
 
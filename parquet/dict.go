@@ -3,6 +3,7 @@ package parquet
 import (
 	"errors"
 	"fmt"
+	"math/bits"
 )
 
 // TODO: store values as []interface{} when decoding to []interface{}
@@ -52,21 +53,118 @@ func (d *dictDecoder) initValues(values interface{}, dictData []byte) error {
 }
 
 func (d *dictDecoder) decodeKeys(n int) (keys []int32, err error) {
-	if d.numValues == 0 {
-		return nil, errors.New("dict: no values can be decoded from an empty dictionary")
-	}
 	if n > cap(d.ind) {
 		d.ind = make([]int32, n) // TODO: uint32
 	}
 	for i := 0; i < n; i++ {
-		k, err := d.keysDecoder.next()
-		if err != nil {
+		if d.ind[i], err = d.nextKey(); err != nil {
 			return nil, err
 		}
-		if k < 0 || int(k) >= d.numValues {
-			return nil, fmt.Errorf("dict: invalid index %d, len(values) = %d", k, d.numValues)
-		}
-		d.ind[i] = k
 	}
 	return d.ind[:n], nil
 }
+
+func (d *dictDecoder) skip(n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := d.nextKey(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *dictDecoder) nextKey() (int32, error) {
+	if d.numValues == 0 {
+		return 0, errors.New("dict: no values can be decoded from an empty dictionary")
+	}
+	k, err := d.keysDecoder.next()
+	if err != nil {
+		return 0, err
+	}
+	if k < 0 || int(k) >= d.numValues {
+		return 0, fmt.Errorf("dict: invalid index %d, len(values) = %d", k, d.numValues)
+	}
+	return k, nil
+}
+
+// dictValuesEncoder encodes values of data pages as keys of a dictionary that
+// is shared by all pages of a column chunk.
+type dictValuesEncoder interface {
+	valuesEncoder
+
+	// dictSize returns the size of PLAIN encoded dictionary entries.
+	dictSize() int
+
+	// numEntries returns the number of entries in the dictionary.
+	numEntries() int
+
+	// flushDict returns PLAIN encoded dictionary entries.
+	flushDict() []byte
+
+	// rawSize returns the size of values of the current page if they were
+	// PLAIN encoded.
+	rawSize() int
+
+	// fallBackTo encodes values of the current page with e and removes them
+	// from the dictionary encoder. The dictionary is not changed.
+	fallBackTo(e valuesEncoder) error
+}
+
+// dictEncoder is the common part of dictionary encoders for all types. Typed
+// encoders map values to keys and add new entries to dict.
+type dictEncoder struct {
+	dict  valuesEncoder // PLAIN encoder of dictionary entries
+	count int           // number of dictionary entries
+
+	keys []int32 // keys of values of the current page
+	raw  int     // size of PLAIN encoded values of the current page
+
+	keysEncoder *rleEncoder
+	data        []byte
+}
+
+// keyBitWidth returns the bit width of keys of the dictionary.
+func (e *dictEncoder) keyBitWidth() int {
+	if e.count <= 1 {
+		return 1
+	}
+	return bits.Len32(uint32(e.count - 1))
+}
+
+// size returns the maximum size of the encoded keys of the current page.
+func (e *dictEncoder) size() int {
+	return 1 + (len(e.keys)*e.keyBitWidth()+7)/8
+}
+
+// flush returns the bit width of keys followed by RLE/Bit-Packing Hybrid
+// encoded keys of the current page.
+func (e *dictEncoder) flush() []byte {
+	w := e.keyBitWidth()
+	if e.keysEncoder == nil || e.keysEncoder.bitWidth != w {
+		e.keysEncoder = newRLEEncoder(w)
+	}
+	for _, k := range e.keys {
+		e.keysEncoder.encode(k)
+	}
+	e.data = append(e.data[:0], byte(w))
+	e.data = append(e.data, e.keysEncoder.flush()...)
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return e.data
+}
+
+func (e *dictEncoder) dictSize() int {
+	return e.dict.size()
+}
+
+func (e *dictEncoder) numEntries() int {
+	return e.count
+}
+
+func (e *dictEncoder) flushDict() []byte {
+	return e.dict.flush()
+}
+
+func (e *dictEncoder) rawSize() int {
+	return e.raw
+}
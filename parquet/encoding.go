@@ -0,0 +1,17 @@
+package parquet
+
+type valuesEncoder interface {
+	// encode encodes all values from values slice and appends the result to
+	// the data encoded so far.
+	//
+	// values must be a slice of interface{} or of a type that corresponds to
+	// the column type (such as []int32 for INT32 column).
+	encode(values interface{}) error
+
+	// size returns the number of bytes encoded so far.
+	size() int
+
+	// flush returns all encoded data and resets the encoder. The returned
+	// slice is only valid until the next call to encode.
+	flush() []byte
+}
@@ -4,16 +4,39 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/kostya-sh/parquet-go/parquetformat"
 )
 
+// File is a parquet file opened for reading. Column chunks are read with
+// independent offset based reads: ColumnChunkReaders created by NewReader
+// can be used concurrently from different goroutines (a single
+// ColumnChunkReader is not safe for concurrent use).
 type File struct {
 	MetaData *parquetformat.FileMetaData
 	Schema   Schema
 
+	// OpenChunkFile opens a file that contains column chunks stored outside
+	// of the parquet file (ColumnChunk.FilePath is set, e.g. in _metadata
+	// files of datasets). path is the value of ColumnChunk.FilePath, it is
+	// relative to the location of the parquet file. OpenFile sets
+	// OpenChunkFile to open files relative to the directory of the opened
+	// file (absolute paths and paths outside of the directory are
+	// rejected).
+	//
+	// Every file is opened once, files that implement io.Closer are closed
+	// by Close. Files that don't implement io.ReaderAt are read under a
+	// lock.
+	OpenChunkFile func(path string) (io.ReadSeeker, error)
+
 	ownReader bool
-	reader    io.ReadSeeker
+	reader    io.ReaderAt
+
+	mu         sync.Mutex // guards chunkFiles
+	chunkFiles map[string]io.ReaderAt
 }
 
 // OpenFile opens a parquet file for reading.
@@ -23,38 +46,109 @@ func OpenFile(path string) (*File, error) {
 		return nil, fmt.Errorf("parquet: failed to open file: %s", err)
 	}
 
-	f, err := FileFromReader(r)
+	fi, err := r.Stat()
+	if err != nil {
+		_ = r.Close()
+		return nil, fmt.Errorf("parquet: failed to open file: %s", err)
+	}
+	f, err := FileFromReaderAt(r, fi.Size())
 	if err != nil {
 		_ = r.Close()
 		return nil, err
 	}
 	f.ownReader = true
+	dir := filepath.Dir(path)
+	f.OpenChunkFile = func(path string) (io.ReadSeeker, error) {
+		// paths come from the file metadata and cannot be trusted
+		p := filepath.Clean(filepath.FromSlash(path))
+		if filepath.IsAbs(p) || filepath.VolumeName(p) != "" ||
+			p == ".." || strings.HasPrefix(p, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("path '%s' is outside of the directory of the file", path)
+		}
+		return os.Open(filepath.Join(dir, p))
+	}
 
 	return f, nil
 }
 
-// FileFromReader creates parquet.File from io.ReadSeeker.
+// FileFromReader creates parquet.File from io.ReadSeeker. If r doesn't
+// implement io.ReaderAt then column chunks are read by seeking r under a
+// lock, r must not be used by other code while the file is open.
 func FileFromReader(r io.ReadSeeker) (*File, error) {
 	meta, err := ReadFileMetaData(r)
 	if err != nil {
 		return nil, fmt.Errorf("parquet: failed to read metadata: %s", err)
 	}
+	return newFile(meta, toReaderAt(r))
+}
+
+// FileFromReaderAt creates parquet.File from io.ReaderAt that provides access
+// to size bytes of a parquet file.
+func FileFromReaderAt(r io.ReaderAt, size int64) (*File, error) {
+	meta, err := ReadFileMetaData(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("parquet: failed to read metadata: %s", err)
+	}
+	return newFile(meta, r)
+}
+
+func newFile(meta *parquetformat.FileMetaData, r io.ReaderAt) (*File, error) {
 	schema, err := MakeSchema(meta)
 	if err != nil {
 		return nil, fmt.Errorf("parquet: failed to parse schema: %s", err)
 	}
 
 	return &File{
-		MetaData: meta,
-		Schema:   schema,
-		reader:   r,
+		MetaData:   meta,
+		Schema:     schema,
+		reader:     r,
+		chunkFiles: make(map[string]io.ReaderAt),
 	}, nil
 }
 
 // NewReader creates a ColumnChunkReader for readng a single column chunk for
 // column col from a row group rg.
-func (f File) NewReader(col Column, rg int) (*ColumnChunkReader, error) {
-	if rg >= len(f.MetaData.RowGroups) {
+func (f *File) NewReader(col Column, rg int) (*ColumnChunkReader, error) {
+	chunk, err := f.columnChunk(col, rg)
+	if err != nil {
+		return nil, err
+	}
+	r, err := f.chunkReader(chunk)
+	if err != nil {
+		return nil, err
+	}
+	return newColumnChunkReader(r, f.MetaData, col, chunk, f.MetaData.RowGroups[rg].NumRows)
+}
+
+// chunkReader returns the reader of the file that contains chunk.
+func (f *File) chunkReader(chunk *parquetformat.ColumnChunk) (io.ReaderAt, error) {
+	if chunk.FilePath == nil {
+		return f.reader, nil
+	}
+	path := *chunk.FilePath
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r, ok := f.chunkFiles[path]; ok {
+		return r, nil
+	}
+	if f.OpenChunkFile == nil {
+		return nil, fmt.Errorf("parquet: column chunk is stored in another file '%s' and OpenChunkFile is not set", path)
+	}
+	r, err := f.OpenChunkFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: failed to open column chunk file: %s", err)
+	}
+	if f.chunkFiles == nil {
+		f.chunkFiles = make(map[string]io.ReaderAt)
+	}
+	ra := toReaderAt(r)
+	f.chunkFiles[path] = ra
+	return ra, nil
+}
+
+// columnChunk returns the column chunk of column col in row group rg.
+func (f *File) columnChunk(col Column, rg int) (*parquetformat.ColumnChunk, error) {
+	if rg < 0 || rg >= len(f.MetaData.RowGroups) {
 		return nil, fmt.Errorf("no such rowgroup: %d", rg)
 	}
 	chunks := f.MetaData.RowGroups[rg].Columns
@@ -62,15 +156,65 @@ func (f File) NewReader(col Column, rg int) (*ColumnChunkReader, error) {
 		return nil, fmt.Errorf("rowgroup %d has %d column chunks, column %d requested",
 			rg, len(chunks), col.Index())
 	}
-	return newColumnChunkReader(f.reader, f.MetaData, col, chunks[col.Index()])
+	return chunks[col.Index()], nil
 }
 
 // Close frees up all resources held by f.
 func (f *File) Close() error {
+	var err error
+	f.mu.Lock()
+	for path, r := range f.chunkFiles {
+		if cerr := closeReader(r); err == nil {
+			err = cerr
+		}
+		delete(f.chunkFiles, path)
+	}
+	f.mu.Unlock()
 	if !f.ownReader {
-		return nil
+		return err
+	}
+	if cerr := closeReader(f.reader); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// seekReaderAt implements io.ReaderAt for io.ReadSeeker that doesn't
+// implement it by seeking before every read.
+type seekReaderAt struct {
+	mu sync.Mutex
+	rs io.ReadSeeker
+}
+
+func (r *seekReaderAt) ReadAt(p []byte, off int64) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err = r.rs.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err = io.ReadFull(r.rs, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// toReaderAt returns r if it implements io.ReaderAt or wraps it otherwise.
+func toReaderAt(r io.ReadSeeker) io.ReaderAt {
+	if ra, ok := r.(io.ReaderAt); ok {
+		return ra
+	}
+	return &seekReaderAt{rs: r}
+}
+
+// closeReader closes r (or the io.ReadSeeker wrapped by it) if it implements
+// io.Closer.
+func closeReader(r io.ReaderAt) error {
+	var v interface{} = r
+	if sr, ok := r.(*seekReaderAt); ok {
+		v = sr.rs
 	}
-	if c, ok := f.reader.(io.Closer); ok {
+	if c, ok := v.(io.Closer); ok {
 		return c.Close()
 	}
 	return nil
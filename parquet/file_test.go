@@ -0,0 +1,263 @@
+package parquet
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// createMetadataFile returns a parquet file without data that references
+// column chunks of the parquet file data stored at path.
+func createMetadataFile(t *testing.T, data []byte, path string) []byte {
+	f, err := FileFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	for _, rg := range f.MetaData.RowGroups {
+		for _, chunk := range rg.Columns {
+			chunk.FilePath = &path
+		}
+	}
+	buf := bytes.NewBuffer(append([]byte{}, magic...))
+	if err = WriteFileMetaData(buf, f.MetaData); err != nil {
+		t.Fatalf("failed to write metadata: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func checkSameCells(t *testing.T, got *File, want *File) {
+	t.Helper()
+	for rg := range want.MetaData.RowGroups {
+		for _, col := range want.Schema.Columns() {
+			wantCells, err := readCells(want, col, rg)
+			if err != nil {
+				t.Fatalf("failed to read column %s: %s", col, err)
+			}
+			gotCells, err := readCells(got, col, rg)
+			if err != nil {
+				t.Errorf("column %s: failed to read: %s", col, err)
+				continue
+			}
+			if !reflect.DeepEqual(gotCells, wantCells) {
+				t.Errorf("column %s: got %v, want %v", col, gotCells, wantCells)
+			}
+		}
+	}
+}
+
+func TestExternalColumnChunks(t *testing.T) {
+	data := createPageIndexTestFile(t)
+	orig, err := FileFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	meta := createMetadataFile(t, data, "data/part-0.parquet")
+
+	f, err := FileFromReader(bytes.NewReader(meta))
+	if err != nil {
+		t.Fatalf("failed to read metadata file: %s", err)
+	}
+	if _, err = f.NewReader(f.Schema.Columns()[0], 0); err == nil {
+		t.Errorf("error expected when OpenChunkFile is not set")
+	}
+
+	var opened []string
+	f.OpenChunkFile = func(path string) (io.ReadSeeker, error) {
+		opened = append(opened, path)
+		return bytes.NewReader(data), nil
+	}
+	checkSameCells(t, f, orig)
+	if want := []string{"data/part-0.parquet"}; !reflect.DeepEqual(opened, want) {
+		t.Errorf("opened %v, want %v", opened, want)
+	}
+	oi, err := f.ReadOffsetIndex(f.Schema.Columns()[0], 0)
+	if err != nil || oi == nil {
+		t.Errorf("failed to read offset index: %v (err = %v)", oi, err)
+	}
+	if err = f.Close(); err != nil {
+		t.Errorf("failed to close: %s", err)
+	}
+}
+
+func TestOpenFileExternalColumnChunks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := createPageIndexTestFile(t)
+	if err = os.Mkdir(filepath.Join(dir, "data"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "data", "part-0.parquet"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	meta := createMetadataFile(t, data, "data/part-0.parquet")
+	if err = ioutil.WriteFile(filepath.Join(dir, "_metadata"), meta, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	orig, err := FileFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	f, err := OpenFile(filepath.Join(dir, "_metadata"))
+	if err != nil {
+		t.Fatalf("failed to open metadata file: %s", err)
+	}
+	checkSameCells(t, f, orig)
+	if err = f.Close(); err != nil {
+		t.Errorf("failed to close: %s", err)
+	}
+}
+
+func TestOpenFileExternalColumnChunksOutsideDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := createPageIndexTestFile(t)
+	if err = ioutil.WriteFile(filepath.Join(dir, "part-0.parquet"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	sub := filepath.Join(dir, "sub")
+	if err = os.Mkdir(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{
+		"../part-0.parquet",
+		"a/../../part-0.parquet",
+		filepath.ToSlash(filepath.Join(dir, "part-0.parquet")),
+	} {
+		meta := createMetadataFile(t, data, path)
+		if err = ioutil.WriteFile(filepath.Join(sub, "_metadata"), meta, 0644); err != nil {
+			t.Fatal(err)
+		}
+		f, err := OpenFile(filepath.Join(sub, "_metadata"))
+		if err != nil {
+			t.Fatalf("failed to open metadata file: %s", err)
+		}
+		if _, err = f.NewReader(f.Schema.Columns()[0], 0); err == nil {
+			t.Errorf("%s: error expected for a column chunk file outside of the directory", path)
+		}
+		if err = f.Close(); err != nil {
+			t.Errorf("failed to close: %s", err)
+		}
+	}
+}
+
+// readSeekerOnly hides io.ReaderAt implemented by the wrapped reader.
+type readSeekerOnly struct {
+	io.ReadSeeker
+}
+
+func TestInterleavedColumnChunkReaders(t *testing.T) {
+	data := createPageIndexTestFile(t)
+	for _, r := range []io.ReadSeeker{bytes.NewReader(data), readSeekerOnly{bytes.NewReader(data)}} {
+		f, err := FileFromReader(r)
+		if err != nil {
+			t.Fatalf("failed to read file: %s", err)
+		}
+		col := f.Schema.Columns()[0]
+		cr1, err := f.NewReader(col, 0)
+		if err != nil {
+			t.Fatalf("failed to create reader: %s", err)
+		}
+		cr2, err := f.NewReader(col, 0)
+		if err != nil {
+			t.Fatalf("failed to create reader: %s", err)
+		}
+		values, dLevels, rLevels := make([]int64, 2), make([]uint16, 2), make([]uint16, 2)
+		var got1, got2 []int64
+		var done1, done2 bool
+		for i := 0; !done1 || !done2; i++ {
+			if !done1 {
+				n, err := cr1.Read(values, dLevels, rLevels)
+				if err != nil && err != EndOfChunk {
+					t.Fatalf("reader 1: %s", err)
+				}
+				done1 = err == EndOfChunk
+				got1 = append(got1, values[:n]...)
+			}
+			if !done2 && i%2 == 0 {
+				n, err := cr2.Read(values, dLevels, rLevels)
+				if err != nil && err != EndOfChunk {
+					t.Fatalf("reader 2: %s", err)
+				}
+				done2 = err == EndOfChunk
+				got2 = append(got2, values[:n]...)
+			}
+		}
+		if want := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(got1, want) {
+			t.Errorf("reader 1: got %v, want %v", got1, want)
+		}
+		if want := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(got2, want) {
+			t.Errorf("reader 2: got %v, want %v", got2, want)
+		}
+	}
+}
+
+func TestConcurrentColumnChunkReaders(t *testing.T) {
+	data := createWriterTestFile(t)
+	orig, err := FileFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	f, err := FileFromReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	if _, err = FileFromReaderAt(bytes.NewReader(data), int64(len(data)-1)); err == nil {
+		t.Errorf("error expected for a truncated file")
+	}
+
+	type result struct {
+		col   Column
+		rg    int
+		cells []cell
+		err   error
+	}
+	var (
+		wg      sync.WaitGroup
+		results = make(chan result, 100)
+	)
+	for i := 0; i < 4; i++ {
+		for rg := range f.MetaData.RowGroups {
+			for _, col := range f.Schema.Columns() {
+				wg.Add(1)
+				go func(col Column, rg int) {
+					defer wg.Done()
+					cells, err := readCells(f, col, rg)
+					results <- result{col, rg, cells, err}
+				}(col, rg)
+			}
+		}
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for r := range results {
+		if r.err != nil {
+			t.Errorf("column %s, row group %d: %s", r.col, r.rg, r.err)
+			continue
+		}
+		want, err := readCells(orig, r.col, r.rg)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", r.col, err)
+		}
+		if !reflect.DeepEqual(r.cells, want) {
+			t.Errorf("column %s, row group %d: got %v, want %v", r.col, r.rg, r.cells, want)
+		}
+	}
+}
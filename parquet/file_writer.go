@@ -0,0 +1,334 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+const createdBy = "parquet-go (github.com/kostya-sh/parquet-go)"
+
+const (
+	// DefaultPageSize is the default approximate size of a data page.
+	DefaultPageSize = 1024 * 1024
+
+	// DefaultPageRowCount is the default maximum number of rows in a data
+	// page.
+	DefaultPageRowCount = 20000
+
+	// DefaultDictionaryPageSize is the default maximum size of a dictionary
+	// page.
+	DefaultDictionaryPageSize = 1024 * 1024
+
+	// DefaultDeltaBlockSize is the default number of values in a block of
+	// DELTA_BINARY_PACKED encoded data.
+	DefaultDeltaBlockSize = 128
+
+	// DefaultDeltaMiniBlocks is the default number of miniblocks in a block
+	// of DELTA_BINARY_PACKED encoded data.
+	DefaultDeltaMiniBlocks = 4
+
+	// DefaultStatisticsTruncateLength is the default maximum length of
+	// BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY min and max statistics.
+	DefaultStatisticsTruncateLength = 64
+)
+
+// FileWriter allows to write data in parquet format.
+//
+// Data is written one row group at a time. A row group is started with
+// StartRowGroup and then a column chunk has to be written for every column
+// in the schema. Close writes the file metadata and must be called to
+// produce a valid parquet file.
+type FileWriter struct {
+	MetaData *parquetformat.FileMetaData
+	Schema   Schema
+
+	// PageSize is the approximate size (in bytes) of encoded data in a single
+	// data page. DefaultPageSize is used if PageSize is 0.
+	PageSize int
+
+	// PageRowCount is the maximum number of rows in a single data page.
+	// DefaultPageRowCount is used if PageRowCount is 0.
+	PageRowCount int
+
+	// DictionaryPageSize is the maximum size (in bytes) of the dictionary of
+	// a dictionary encoded column chunk, see ColumnChunkWriter.SetEncoding.
+	// DefaultDictionaryPageSize is used if DictionaryPageSize is 0.
+	DictionaryPageSize int
+
+	// StatisticsTruncateLength is the maximum length (in bytes) of min and
+	// max statistics of byte arrays compared as unsigned bytes (e.g.
+	// strings) in data page headers, column chunk metadata and column
+	// indexes. Longer min values are truncated, longer max values are
+	// truncated and incremented so that they remain upper bounds.
+	// DefaultStatisticsTruncateLength is used if StatisticsTruncateLength is
+	// 0, values are not truncated if it is negative.
+	StatisticsTruncateLength int
+
+	// Compression is the default compression codec of column chunks.
+	Compression parquetformat.CompressionCodec
+
+	ownWriter bool
+	writer    *countingWriter
+
+	rowGroup *parquetformat.RowGroup
+	chunks   int
+	closed   bool
+
+	// page indexes of written column chunks, they are written to the file
+	// before the file metadata
+	pageIndexes []chunkPageIndex
+}
+
+type chunkPageIndex struct {
+	chunk       *parquetformat.ColumnChunk
+	columnIndex *parquetformat.ColumnIndex // nil if there is no column index
+	offsetIndex *parquetformat.OffsetIndex
+}
+
+// CreateFile creates a parquet file with the given schema for writing. If the
+// file already exists it is truncated.
+func CreateFile(path string, schema Schema) (*FileWriter, error) {
+	w, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: failed to create file: %s", err)
+	}
+
+	fw, err := NewFileWriter(w, schema)
+	if err != nil {
+		_ = w.Close()
+		return nil, err
+	}
+	fw.ownWriter = true
+
+	return fw, nil
+}
+
+// NewFileWriter creates a FileWriter that writes data with the given schema
+// in parquet format to w.
+func NewFileWriter(w io.Writer, schema Schema) (*FileWriter, error) {
+	if len(schema.elements) == 0 {
+		return nil, fmt.Errorf("parquet: empty schema")
+	}
+
+	cw := &countingWriter{w: w}
+	if _, err := cw.Write(magic); err != nil {
+		return nil, fmt.Errorf("parquet: failed to write header: %s", err)
+	}
+
+	// min/max statistics are computed using the type defined order
+	orders := make([]*parquetformat.ColumnOrder, len(schema.Columns()))
+	for i := range orders {
+		orders[i] = &parquetformat.ColumnOrder{TYPE_ORDER: &parquetformat.TypeDefinedOrder{}}
+	}
+
+	created := createdBy
+	return &FileWriter{
+		MetaData: &parquetformat.FileMetaData{
+			Version:      1,
+			Schema:       schema.elements,
+			RowGroups:    []*parquetformat.RowGroup{},
+			CreatedBy:    &created,
+			ColumnOrders: orders,
+		},
+		Schema: schema,
+		writer: cw,
+	}, nil
+}
+
+// StartRowGroup finishes the current row group (if any) and starts a new one.
+//
+// An error is returned if not all column chunks have been written for the
+// current row group.
+func (fw *FileWriter) StartRowGroup() error {
+	if fw.closed {
+		return fmt.Errorf("parquet: file writer is closed")
+	}
+	if err := fw.endRowGroup(); err != nil {
+		return err
+	}
+	fw.rowGroup = &parquetformat.RowGroup{
+		Columns: make([]*parquetformat.ColumnChunk, len(fw.Schema.Columns())),
+	}
+	fw.chunks = 0
+	return nil
+}
+
+func (fw *FileWriter) endRowGroup() error {
+	rg := fw.rowGroup
+	if rg == nil {
+		return nil
+	}
+	fw.rowGroup = nil
+	if fw.chunks == 0 {
+		// nothing has been written, skip this row group
+		return nil
+	}
+
+	for i, chunk := range rg.Columns {
+		if chunk == nil {
+			return fmt.Errorf("parquet: column %s has not been written in row group %d",
+				fw.Schema.Columns()[i], len(fw.MetaData.RowGroups))
+		}
+		rg.TotalByteSize += chunk.MetaData.TotalUncompressedSize
+	}
+
+	fw.MetaData.RowGroups = append(fw.MetaData.RowGroups, rg)
+	fw.MetaData.NumRows += rg.NumRows
+	return nil
+}
+
+// NewWriter creates a ColumnChunkWriter for writing a column chunk for column
+// col to the current row group.
+func (fw *FileWriter) NewWriter(col Column) (*ColumnChunkWriter, error) {
+	if err := fw.checkWritable(col); err != nil {
+		return nil, err
+	}
+	return newColumnChunkWriter(fw, col), nil
+}
+
+// WriteColumnChunk writes a complete column chunk for column col to the
+// current row group. It is a shortcut for NewWriter followed by a single call
+// to ColumnChunkWriter.Write and ColumnChunkWriter.Close.
+func (fw *FileWriter) WriteColumnChunk(col Column, values interface{}, dLevels []uint16, rLevels []uint16) error {
+	cw, err := fw.NewWriter(col)
+	if err != nil {
+		return err
+	}
+	if err = cw.Write(values, dLevels, rLevels); err != nil {
+		return err
+	}
+	return cw.Close()
+}
+
+func (fw *FileWriter) checkWritable(col Column) error {
+	if fw.closed {
+		return fmt.Errorf("parquet: file writer is closed")
+	}
+	if fw.rowGroup == nil {
+		return fmt.Errorf("parquet: no row group has been started")
+	}
+	if err := fw.checkColumn(col); err != nil {
+		return err
+	}
+	if fw.rowGroup.Columns[col.Index()] != nil {
+		return fmt.Errorf("parquet: column %s has already been written", col)
+	}
+	return nil
+}
+
+// addColumnChunk writes data of a column chunk from cw to the file.
+func (fw *FileWriter) addColumnChunk(cw *ColumnChunkWriter) error {
+	col := cw.col
+	if cw.rowGroup != fw.rowGroup {
+		return fmt.Errorf("parquet: row group of column %s has already been finished", col)
+	}
+	if err := fw.checkWritable(col); err != nil {
+		return err
+	}
+	if fw.chunks > 0 && cw.numRows != fw.rowGroup.NumRows {
+		return fmt.Errorf("parquet: column %s has %d rows, other columns in the row group have %d rows",
+			col, cw.numRows, fw.rowGroup.NumRows)
+	}
+
+	offset := fw.writer.n
+	if _, err := fw.writer.Write(cw.pages.Bytes()); err != nil {
+		return err
+	}
+	meta := cw.chunkMeta
+	meta.DataPageOffset += offset
+	if meta.DictionaryPageOffset != nil {
+		*meta.DictionaryPageOffset += offset
+	}
+	for _, loc := range cw.pageLocations {
+		loc.Offset += offset
+	}
+
+	chunk := &parquetformat.ColumnChunk{
+		FileOffset: offset,
+		MetaData:   meta,
+	}
+	fw.rowGroup.Columns[col.Index()] = chunk
+	fw.pageIndexes = append(fw.pageIndexes, chunkPageIndex{
+		chunk:       chunk,
+		columnIndex: cw.columnIndex,
+		offsetIndex: &parquetformat.OffsetIndex{PageLocations: cw.pageLocations},
+	})
+	fw.rowGroup.NumRows = cw.numRows
+	fw.chunks++
+	return nil
+}
+
+func (fw *FileWriter) checkColumn(col Column) error {
+	cols := fw.Schema.Columns()
+	if i := col.Index(); i >= len(cols) || cols[i] != col {
+		return fmt.Errorf("parquet: column %s does not belong to the schema", col)
+	}
+	return nil
+}
+
+// Close finishes the current row group and writes the file metadata. If fw
+// has been created with CreateFile the underlying file is closed as well.
+func (fw *FileWriter) Close() error {
+	if fw.closed {
+		return nil
+	}
+	fw.closed = true
+
+	err := fw.endRowGroup()
+	if err == nil {
+		err = fw.writePageIndexes()
+	}
+	if err == nil {
+		err = WriteFileMetaData(fw.writer, fw.MetaData)
+	}
+
+	if fw.ownWriter {
+		if c, ok := fw.writer.w.(io.Closer); ok {
+			if cerr := c.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}
+	return err
+}
+
+// writePageIndexes writes column indexes and then offset indexes of all
+// column chunks and updates their locations in the column chunk metadata.
+func (fw *FileWriter) writePageIndexes() error {
+	for _, pi := range fw.pageIndexes {
+		if pi.columnIndex == nil {
+			continue
+		}
+		offset := fw.writer.n
+		if err := pi.columnIndex.Write(fw.writer); err != nil {
+			return fmt.Errorf("parquet: failed to write column index: %s", err)
+		}
+		length := int32(fw.writer.n - offset)
+		pi.chunk.ColumnIndexOffset = &offset
+		pi.chunk.ColumnIndexLength = &length
+	}
+	for _, pi := range fw.pageIndexes {
+		offset := fw.writer.n
+		if err := pi.offsetIndex.Write(fw.writer); err != nil {
+			return fmt.Errorf("parquet: failed to write offset index: %s", err)
+		}
+		length := int32(fw.writer.n - offset)
+		pi.chunk.OffsetIndexOffset = &offset
+		pi.chunk.OffsetIndexLength = &length
+	}
+	return nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (n int, err error) {
+	n, err = w.w.Write(p)
+	w.n += int64(n)
+	return
+}
@@ -0,0 +1,268 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+var writerTestMeta = createFileMetaData(
+	&pf.SchemaElement{
+		Name:        "Test",
+		NumChildren: int32Ptr(7),
+	},
+	&pf.SchemaElement{
+		Name:           "i32",
+		Type:           typeInt32,
+		RepetitionType: frtRequired,
+	},
+	&pf.SchemaElement{
+		Name:           "i64",
+		Type:           typeInt64,
+		RepetitionType: frtOptional,
+	},
+	&pf.SchemaElement{
+		Name:           "b",
+		Type:           typeBoolean,
+		RepetitionType: frtRepeated,
+	},
+	&pf.SchemaElement{
+		Name:           "g",
+		RepetitionType: frtOptional,
+		NumChildren:    int32Ptr(3),
+	},
+	&pf.SchemaElement{
+		Name:           "f",
+		Type:           typeFloat,
+		RepetitionType: frtRequired,
+	},
+	&pf.SchemaElement{
+		Name:           "d",
+		Type:           typeDouble,
+		RepetitionType: frtRepeated,
+	},
+	&pf.SchemaElement{
+		Name:           "s",
+		Type:           typeByteArray,
+		RepetitionType: frtOptional,
+		ConvertedType:  ctUTF8,
+	},
+	&pf.SchemaElement{
+		Name:           "r",
+		RepetitionType: frtRequired,
+		NumChildren:    int32Ptr(1),
+	},
+	&pf.SchemaElement{
+		Name:           "i96",
+		Type:           typeInt96,
+		RepetitionType: frtRequired,
+	},
+	&pf.SchemaElement{
+		Name:           "fixed",
+		Type:           typeFixedLenByteArray,
+		TypeLength:     int32Ptr(3),
+		RepetitionType: frtOptional,
+	},
+	&pf.SchemaElement{
+		Name:           "empty",
+		Type:           typeInt32,
+		RepetitionType: frtOptional,
+	},
+)
+
+// writerTestData contains 2 row groups with cells for every column
+var writerTestData = [][][]cell{
+	{
+		// i32
+		{{0, 0, int32(1)}, {0, 0, int32(2)}, {0, 0, int32(-3)}},
+		// i64
+		{{1, 0, int64(10)}, {0, 0, nil}, {1, 0, int64(-30)}},
+		// b
+		{{1, 0, true}, {1, 1, false}, {0, 0, nil}, {1, 0, true}},
+		// g.f
+		{{1, 0, float32(1.5)}, {0, 0, nil}, {1, 0, float32(3.5)}},
+		// g.d
+		{{2, 0, float64(1)}, {2, 1, float64(2)}, {0, 0, nil}, {1, 0, nil}},
+		// g.s
+		{{2, 0, []byte("abc")}, {0, 0, nil}, {1, 0, nil}},
+		// r.i96
+		{{0, 0, Int96{1}}, {0, 0, Int96{2}}, {0, 0, Int96{3}}},
+		// fixed
+		{{1, 0, []byte("xyz")}, {1, 0, []byte("123")}, {0, 0, nil}},
+		// empty
+		{{0, 0, nil}, {0, 0, nil}, {0, 0, nil}},
+	},
+	{
+		{{0, 0, int32(4)}},
+		{{1, 0, int64(40)}},
+		{{1, 0, false}, {1, 1, false}, {1, 1, true}},
+		{{1, 0, float32(4.5)}},
+		{{1, 0, nil}},
+		{{2, 0, []byte("")}},
+		{{0, 0, Int96{4}}},
+		{{0, 0, nil}},
+		{{0, 0, nil}},
+	},
+}
+
+func writeCells(fw *FileWriter, col Column, cells []cell) error {
+	values := make([]interface{}, 0, len(cells))
+	dLevels := make([]uint16, len(cells))
+	rLevels := make([]uint16, len(cells))
+	for i, c := range cells {
+		dLevels[i] = c.d
+		rLevels[i] = c.r
+		if c.v != nil {
+			values = append(values, c.v)
+		}
+	}
+	return fw.WriteColumnChunk(col, values, dLevels, rLevels)
+}
+
+func readCells(f *File, col Column, rg int) ([]cell, error) {
+	cr, err := f.NewReader(col, rg)
+	if err != nil {
+		return nil, err
+	}
+	var cells []cell
+	values := make([]interface{}, 2)
+	dLevels := make([]uint16, 2)
+	rLevels := make([]uint16, 2)
+	for {
+		n, err := cr.Read(values, dLevels, rLevels)
+		if err == EndOfChunk {
+			return cells, nil
+		}
+		if err != nil {
+			return cells, err
+		}
+		for i, vi := 0, 0; i < n; i++ {
+			c := cell{dLevels[i], rLevels[i], nil}
+			if dLevels[i] == col.MaxD() {
+				c.v = values[vi]
+				vi++
+			}
+			cells = append(cells, c)
+		}
+	}
+}
+
+func writeTestFile(t *testing.T, fw *FileWriter, data [][][]cell) {
+	t.Helper()
+	for _, rg := range data {
+		if err := fw.StartRowGroup(); err != nil {
+			t.Fatalf("failed to start row group: %s", err)
+		}
+		for c, col := range fw.Schema.Columns() {
+			if err := writeCells(fw, col, rg[c]); err != nil {
+				t.Fatalf("failed to write column %s: %s", col, err)
+			}
+		}
+	}
+	if err := fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+}
+
+func checkTestFile(t *testing.T, buf []byte, data [][][]cell) {
+	t.Helper()
+	f, err := FileFromReader(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	if len(f.MetaData.RowGroups) != len(data) {
+		t.Fatalf("got %d row groups, want %d", len(f.MetaData.RowGroups), len(data))
+	}
+	for rg := range data {
+		for c, col := range f.Schema.Columns() {
+			cells, err := readCells(f, col, rg)
+			if err != nil {
+				t.Errorf("row group %d, column %s: read failed: %s", rg, col, err)
+				continue
+			}
+			if want := data[rg][c]; !reflect.DeepEqual(cells, want) {
+				t.Errorf("row group %d, column %s: got %v, want %v", rg, col, cells, want)
+			}
+		}
+	}
+}
+
+func TestFileWriterRoundTrip(t *testing.T) {
+	schema := mustCreateSchema(writerTestMeta)
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	writeTestFile(t, fw, writerTestData)
+
+	checkTestFile(t, buf.Bytes(), writerTestData)
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	if f.MetaData.NumRows != 4 {
+		t.Errorf("NumRows = %d, want 4", f.MetaData.NumRows)
+	}
+	if got := f.Schema.DisplayString(); got != schema.DisplayString() {
+		t.Errorf("schema of the written file:\n%s\nwant:\n%s", got, schema.DisplayString())
+	}
+}
+
+func TestFileWriterErrors(t *testing.T) {
+	schema := mustCreateSchema(writerTestMeta)
+	cols := schema.Columns()
+	fw, err := NewFileWriter(new(bytes.Buffer), schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+
+	if err = writeCells(fw, cols[0], writerTestData[0][0]); err == nil {
+		t.Errorf("error expected writing a column chunk without a row group")
+	}
+
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	tests := []struct {
+		col   Column
+		cells []cell
+	}{
+		// invalid definition level
+		{cols[1], []cell{{2, 0, int64(1)}}},
+		// invalid repetition level
+		{cols[1], []cell{{1, 1, int64(1)}}},
+		// the first repetition level is not 0
+		{cols[2], []cell{{1, 1, true}}},
+		// not enough values
+		{cols[0], []cell{{0, 0, int32(1)}, {0, 0, nil}}},
+		// invalid value length
+		{cols[7], []cell{{1, 0, []byte("abcd")}}},
+	}
+	for i, test := range tests {
+		if err = writeCells(fw, test.col, test.cells); err == nil {
+			t.Errorf("test %d: error expected", i)
+		} else {
+			t.Logf("test %d: %s", i, err)
+		}
+	}
+
+	if err = writeCells(fw, cols[0], writerTestData[0][0]); err != nil {
+		t.Fatalf("failed to write column chunk: %s", err)
+	}
+	// different number of rows
+	if err = writeCells(fw, cols[1], writerTestData[1][1]); err == nil {
+		t.Errorf("error expected writing column chunks with different number of rows")
+	}
+	// duplicate column
+	if err = writeCells(fw, cols[0], writerTestData[0][0]); err == nil {
+		t.Errorf("error expected writing the same column chunk twice")
+	}
+	// not all columns are written
+	if err = fw.Close(); err == nil {
+		t.Errorf("error expected closing a file with incomplete row group")
+	}
+}
@@ -0,0 +1,415 @@
+package parquet
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// Filter is a predicate over values of columns. Filters are used to skip row
+// groups that cannot contain matching rows using statistics of column chunks
+// (see File.FilterRowGroups).
+//
+// Columns are referenced by names (names of individual elements are separated
+// with "."). Comparison predicates never match null values.
+//
+// A value used in a predicate is converted to the physical type of the column:
+//
+//   - BOOLEAN: bool
+//   - INT32, INT64: any Go integer type, the value must fit into the range of
+//     the column type (unsigned if the column is annotated as unsigned)
+//   - INT96: Int96
+//   - FLOAT, DOUBLE: float32 or float64 (but not NaN)
+//   - BYTE_ARRAY, FIXED_LEN_BYTE_ARRAY: []byte or string
+type Filter interface {
+	// mayMatch returns false if it is known from stats that no value can
+	// match the filter.
+	mayMatch(stats statsFunc) (bool, error)
+
+	// negate returns the logical inverse of the filter.
+	negate() Filter
+}
+
+// statsFunc returns statistics of values of the named column.
+type statsFunc func(column string) (*valueStats, error)
+
+// valueStats describes a set of values of a single column.
+type valueStats struct {
+	min, max  interface{} // nil if unknown
+	nullCount int64       // -1 if unknown
+	numValues int64       // number of values including nulls, -1 if unknown
+	onlyNulls bool        // all values are known to be null
+
+	// compare compares values (nil if values are not comparable)
+	compare func(a, b interface{}) int
+
+	// convert converts a value used in a filter to the type of min and max
+	convert func(v interface{}) (interface{}, error)
+}
+
+// allNull returns true if it is known that all values are null.
+func (s *valueStats) allNull() bool {
+	return s.onlyNulls || s.nullCount >= 0 && s.nullCount == s.numValues
+}
+
+// hasBounds returns true if min and max can be used for comparisons.
+func (s *valueStats) hasBounds() bool {
+	return s.min != nil && s.max != nil && s.compare != nil
+}
+
+type compareOp int
+
+const (
+	opEq compareOp = iota
+	opNe
+	opLt
+	opLe
+	opGt
+	opGe
+)
+
+var inverseOps = [...]compareOp{
+	opEq: opNe,
+	opNe: opEq,
+	opLt: opGe,
+	opLe: opGt,
+	opGt: opLe,
+	opGe: opLt,
+}
+
+type compareFilter struct {
+	op     compareOp
+	column string
+	value  interface{}
+}
+
+// Eq returns a filter that matches values of column equal to value.
+func Eq(column string, value interface{}) Filter {
+	return &compareFilter{op: opEq, column: column, value: value}
+}
+
+// Ne returns a filter that matches values of column not equal to value.
+func Ne(column string, value interface{}) Filter {
+	return &compareFilter{op: opNe, column: column, value: value}
+}
+
+// Lt returns a filter that matches values of column less than value.
+func Lt(column string, value interface{}) Filter {
+	return &compareFilter{op: opLt, column: column, value: value}
+}
+
+// Le returns a filter that matches values of column less than or equal to
+// value.
+func Le(column string, value interface{}) Filter {
+	return &compareFilter{op: opLe, column: column, value: value}
+}
+
+// Gt returns a filter that matches values of column greater than value.
+func Gt(column string, value interface{}) Filter {
+	return &compareFilter{op: opGt, column: column, value: value}
+}
+
+// Ge returns a filter that matches values of column greater than or equal to
+// value.
+func Ge(column string, value interface{}) Filter {
+	return &compareFilter{op: opGe, column: column, value: value}
+}
+
+func (f *compareFilter) mayMatch(stats statsFunc) (bool, error) {
+	s, err := stats(f.column)
+	if err != nil {
+		return false, err
+	}
+	v, err := s.convert(f.value)
+	if err != nil {
+		return false, err
+	}
+	if s.allNull() {
+		return false, nil
+	}
+	if !s.hasBounds() {
+		return true, nil
+	}
+
+	minCmp, maxCmp := s.compare(v, s.min), s.compare(v, s.max)
+	switch f.op {
+	case opEq:
+		return minCmp >= 0 && maxCmp <= 0, nil
+	case opNe:
+		return minCmp != 0 || maxCmp != 0, nil
+	case opLt:
+		return minCmp > 0, nil
+	case opLe:
+		return minCmp >= 0, nil
+	case opGt:
+		return maxCmp < 0, nil
+	case opGe:
+		return maxCmp <= 0, nil
+	}
+	panic("unexpected comparison operator")
+}
+
+func (f *compareFilter) negate() Filter {
+	return &compareFilter{op: inverseOps[f.op], column: f.column, value: f.value}
+}
+
+type inFilter struct {
+	column string
+	values []interface{}
+	not    bool
+}
+
+// In returns a filter that matches values of column equal to any of values.
+func In(column string, values ...interface{}) Filter {
+	return &inFilter{column: column, values: values}
+}
+
+func (f *inFilter) mayMatch(stats statsFunc) (bool, error) {
+	s, err := stats(f.column)
+	if err != nil {
+		return false, err
+	}
+	values := make([]interface{}, len(f.values))
+	for i, v := range f.values {
+		if values[i], err = s.convert(v); err != nil {
+			return false, err
+		}
+	}
+	if s.allNull() {
+		return false, nil
+	}
+	if !s.hasBounds() {
+		return true, nil
+	}
+
+	if f.not {
+		// no match only if all values are the same and belong to the list
+		if s.compare(s.min, s.max) != 0 {
+			return true, nil
+		}
+		for _, v := range values {
+			if s.compare(v, s.min) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	for _, v := range values {
+		if s.compare(v, s.min) >= 0 && s.compare(v, s.max) <= 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *inFilter) negate() Filter {
+	return &inFilter{column: f.column, values: f.values, not: !f.not}
+}
+
+type nullFilter struct {
+	column string
+	not    bool
+}
+
+// IsNull returns a filter that matches null values of column.
+func IsNull(column string) Filter {
+	return &nullFilter{column: column}
+}
+
+func (f *nullFilter) mayMatch(stats statsFunc) (bool, error) {
+	s, err := stats(f.column)
+	if err != nil {
+		return false, err
+	}
+	if f.not {
+		return !s.allNull(), nil
+	}
+	return s.nullCount != 0, nil
+}
+
+func (f *nullFilter) negate() Filter {
+	return &nullFilter{column: f.column, not: !f.not}
+}
+
+type andFilter struct {
+	filters []Filter
+}
+
+// And returns a filter that matches if all filters match. And without
+// arguments matches everything.
+func And(filters ...Filter) Filter {
+	return &andFilter{filters: filters}
+}
+
+func (f *andFilter) mayMatch(stats statsFunc) (bool, error) {
+	for _, filter := range f.filters {
+		ok, err := filter.mayMatch(stats)
+		if !ok || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (f *andFilter) negate() Filter {
+	return &orFilter{filters: negateAll(f.filters)}
+}
+
+type orFilter struct {
+	filters []Filter
+}
+
+// Or returns a filter that matches if any of filters matches. Or without
+// arguments matches nothing.
+func Or(filters ...Filter) Filter {
+	return &orFilter{filters: filters}
+}
+
+func (f *orFilter) mayMatch(stats statsFunc) (bool, error) {
+	match := false
+	for _, filter := range f.filters {
+		ok, err := filter.mayMatch(stats)
+		if err != nil {
+			return false, err
+		}
+		// continue to report invalid filters
+		match = match || ok
+	}
+	return match, nil
+}
+
+func (f *orFilter) negate() Filter {
+	return &andFilter{filters: negateAll(f.filters)}
+}
+
+// Not returns the logical inverse of filter. Inverse of a comparison predicate
+// doesn't match null values either, e.g. Not(Eq("a", 1)) is the same as
+// Ne("a", 1).
+func Not(filter Filter) Filter {
+	return filter.negate()
+}
+
+func negateAll(filters []Filter) []Filter {
+	negated := make([]Filter, len(filters))
+	for i, f := range filters {
+		negated[i] = f.negate()
+	}
+	return negated
+}
+
+// FilterRowGroups returns indexes of row groups of f that may contain rows
+// matching filter. Row groups are skipped using statistics of column chunks,
+// the returned row groups are not guaranteed to contain matching rows.
+func (f *File) FilterRowGroups(filter Filter) ([]int, error) {
+	var rgs []int
+	for rg := range f.MetaData.RowGroups {
+		ok, err := f.RowGroupMayMatch(rg, filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rgs = append(rgs, rg)
+		}
+	}
+	return rgs, nil
+}
+
+// RowGroupMayMatch returns false if it is known from statistics of column
+// chunks that row group rg doesn't contain rows matching filter.
+func (f *File) RowGroupMayMatch(rg int, filter Filter) (bool, error) {
+	if rg < 0 || rg >= len(f.MetaData.RowGroups) {
+		return false, fmt.Errorf("parquet: no such row group: %d", rg)
+	}
+	ok, err := filter.mayMatch(func(name string) (*valueStats, error) {
+		col, found := f.Schema.ColumnByName(name)
+		if !found {
+			return nil, fmt.Errorf("parquet: column %s not found", name)
+		}
+		return f.columnChunkStats(col, rg), nil
+	})
+	return ok && f.MetaData.RowGroups[rg].NumRows != 0, err
+}
+
+// convertFilterValue converts v to the type of values of col. order is the
+// sort order of col.
+func convertFilterValue(col Column, order sortOrder, v interface{}) (interface{}, error) {
+	switch col.Type() {
+	case parquetformat.Type_BOOLEAN:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case parquetformat.Type_INT32:
+		if i, ok := convertInteger(v, 32, order == sortOrderUnsigned); ok {
+			return int32(i), nil
+		}
+	case parquetformat.Type_INT64:
+		if i, ok := convertInteger(v, 64, order == sortOrderUnsigned); ok {
+			return int64(i), nil
+		}
+	case parquetformat.Type_INT96:
+		if i, ok := v.(Int96); ok {
+			return i, nil
+		}
+	case parquetformat.Type_FLOAT:
+		switch f := v.(type) {
+		case float32:
+			if f == f {
+				return f, nil
+			}
+		case float64:
+			// float64 values are not rounded to float32, they are compared
+			// with values of the column as float64
+			if f == f {
+				return f, nil
+			}
+		}
+	case parquetformat.Type_DOUBLE:
+		switch f := v.(type) {
+		case float32:
+			if f == f {
+				return float64(f), nil
+			}
+		case float64:
+			if f == f {
+				return f, nil
+			}
+		}
+	case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		switch b := v.(type) {
+		case []byte:
+			return b, nil
+		case string:
+			return []byte(b), nil
+		}
+	}
+	return nil, fmt.Errorf("parquet: invalid value %#v for column %s of type %s", v, col, col.Type())
+}
+
+// convertInteger converts v of any Go integer type to an integer of the given
+// bit size. The result is the bit representation of the value (two's
+// complement if the integer is signed) stored in uint64.
+func convertInteger(v interface{}, bits uint, unsigned bool) (uint64, bool) {
+	max := uint64(math.MaxUint64) >> (64 - bits)
+	if !unsigned {
+		max >>= 1
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i >= 0 {
+			return uint64(i), uint64(i) <= max
+		}
+		if unsigned || i < -int64(max)-1 {
+			return 0, false
+		}
+		return uint64(i), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		return u, u <= max
+	}
+	return 0, false
+}
@@ -0,0 +1,251 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func int32Stat(v int32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+func doubleStat(v float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+	return b
+}
+
+func chunkWithStats(numValues int64, stats *pf.Statistics) *pf.ColumnChunk {
+	return &pf.ColumnChunk{MetaData: &pf.ColumnMetaData{NumValues: numValues, Statistics: stats}}
+}
+
+func createFilterTestFile(columnOrders bool) *File {
+	meta := createFileMetaData(
+		&pf.SchemaElement{
+			Name:        "Test",
+			NumChildren: int32Ptr(4),
+		},
+		&pf.SchemaElement{
+			Name:           "a",
+			Type:           typeInt32,
+			RepetitionType: frtOptional,
+		},
+		&pf.SchemaElement{
+			Name:           "s",
+			Type:           typeByteArray,
+			RepetitionType: frtOptional,
+			ConvertedType:  ctUTF8,
+		},
+		&pf.SchemaElement{
+			Name:           "u",
+			Type:           typeInt32,
+			RepetitionType: frtRequired,
+			ConvertedType:  pf.ConvertedTypePtr(pf.ConvertedType_UINT_32),
+		},
+		&pf.SchemaElement{
+			Name:           "d",
+			Type:           typeDouble,
+			RepetitionType: frtRequired,
+		},
+	)
+	if columnOrders {
+		for i := 0; i < 4; i++ {
+			meta.ColumnOrders = append(meta.ColumnOrders, &pf.ColumnOrder{TYPE_ORDER: &pf.TypeDefinedOrder{}})
+		}
+	}
+	meta.RowGroups = []*pf.RowGroup{
+		{
+			NumRows: 10,
+			Columns: []*pf.ColumnChunk{
+				chunkWithStats(10, &pf.Statistics{MinValue: int32Stat(1), MaxValue: int32Stat(10), NullCount: int64P(0)}),
+				chunkWithStats(10, &pf.Statistics{MinValue: []byte("apple"), MaxValue: []byte("banana"), NullCount: int64P(0)}),
+				chunkWithStats(10, &pf.Statistics{MinValue: int32Stat(1), MaxValue: int32Stat(-16)}),
+				chunkWithStats(10, &pf.Statistics{Min: doubleStat(-1.5), Max: doubleStat(2.5)}),
+			},
+		},
+		{
+			NumRows: 10,
+			Columns: []*pf.ColumnChunk{
+				chunkWithStats(10, &pf.Statistics{MinValue: int32Stat(20), MaxValue: int32Stat(30), NullCount: int64P(0)}),
+				chunkWithStats(10, &pf.Statistics{MinValue: []byte("cherry"), MaxValue: []byte("kiwi"), NullCount: int64P(2)}),
+				chunkWithStats(10, nil),
+				chunkWithStats(10, nil),
+			},
+		},
+		{
+			NumRows: 10,
+			Columns: []*pf.ColumnChunk{
+				chunkWithStats(10, &pf.Statistics{NullCount: int64P(10)}),
+				// deprecated fields cannot be used for strings
+				chunkWithStats(10, &pf.Statistics{Min: []byte("x"), Max: []byte("z")}),
+				// deprecated fields cannot be used for unsigned integers
+				chunkWithStats(10, &pf.Statistics{Min: int32Stat(1), Max: int32Stat(2)}),
+				chunkWithStats(10, &pf.Statistics{MinValue: doubleStat(0), MaxValue: doubleStat(math.NaN())}),
+			},
+		},
+	}
+	return &File{MetaData: meta, Schema: mustCreateSchema(meta)}
+}
+
+func TestFilterRowGroups(t *testing.T) {
+	f := createFilterTestFile(true)
+
+	tests := []struct {
+		filter Filter
+		want   []int
+	}{
+		{Eq("a", 5), []int{0}},
+		{Eq("a", int64(25)), []int{1}},
+		{Ne("a", 5), []int{0, 1}},
+		{Lt("a", 1), nil},
+		{Le("a", 1), []int{0}},
+		{Gt("a", 30), nil},
+		{Ge("a", uint8(30)), []int{1}},
+		{In("a", 15, 40), nil},
+		{In("a", 15, 30), []int{1}},
+		{In("a"), nil},
+		{IsNull("a"), []int{2}},
+		{Not(IsNull("a")), []int{0, 1}},
+		{Not(In("a", 5)), []int{0, 1}},
+
+		{Eq("s", "banana"), []int{0, 2}},
+		{Eq("s", []byte("zzz")), []int{2}},
+		{Gt("s", "c"), []int{1, 2}},
+		{IsNull("s"), []int{1, 2}},
+
+		{Gt("u", uint32(0xfffffff0)), []int{1, 2}},
+		{Gt("u", 0x7fffffff), []int{0, 1, 2}},
+		{Lt("u", 1), []int{1, 2}},
+
+		{Lt("d", -1.5), []int{1, 2}},
+		{Lt("d", float32(0)), []int{0, 1, 2}},
+		{Gt("d", 2.5), []int{1, 2}},
+
+		{And(Eq("a", 5), Eq("s", "kiwi")), nil},
+		{Or(Eq("a", 5), Eq("s", "kiwi")), []int{0, 1, 2}},
+		{Not(And(Ge("a", 1), Le("a", 10))), []int{1}},
+		{Not(Or(Lt("a", 20), Gt("a", 30))), []int{1}},
+		{Not(Not(Eq("a", 25))), []int{1}},
+		{And(), []int{0, 1, 2}},
+		{Or(), nil},
+	}
+
+	for i, test := range tests {
+		got, err := f.FilterRowGroups(test.filter)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("test %d: got row groups %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestFilterFloatColumn(t *testing.T) {
+	meta := createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(1)},
+		&pf.SchemaElement{Name: "f", Type: typeFloat, RepetitionType: frtRequired},
+	)
+	meta.RowGroups = []*pf.RowGroup{
+		{
+			NumRows: 10,
+			Columns: []*pf.ColumnChunk{
+				chunkWithStats(10, &pf.Statistics{Min: floatStat(0.1), Max: floatStat(0.5)}),
+			},
+		},
+	}
+	f := &File{MetaData: meta, Schema: mustCreateSchema(meta)}
+
+	// float32(0.1) is greater than 0.1
+	tests := []struct {
+		filter Filter
+		want   []int
+	}{
+		{Lt("f", 0.1), nil},
+		{Le("f", 0.1), nil},
+		{Eq("f", 0.1), nil},
+		{In("f", 0.1, 0.6), nil},
+		{Ge("f", 0.1), []int{0}},
+		{Eq("f", float32(0.1)), []int{0}},
+		{Eq("f", float64(float32(0.1))), []int{0}},
+		{Lt("f", 0.10000001), []int{0}},
+		{Gt("f", 0.5000001), nil},
+		{Gt("f", 1e300), nil},
+		{Gt("f", -1e300), []int{0}},
+	}
+	for i, test := range tests {
+		got, err := f.FilterRowGroups(test.filter)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("test %d: got row groups %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestFilterRowGroupsWithoutColumnOrders(t *testing.T) {
+	f := createFilterTestFile(false)
+
+	// min_value and max_value are ignored
+	got, err := f.FilterRowGroups(Eq("a", 100))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []int{0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got row groups %v, want %v", got, want)
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	f := createFilterTestFile(true)
+
+	filters := []Filter{
+		Eq("x", 1),
+		Eq("a", "1"),
+		Eq("a", int64(math.MaxInt32)+1),
+		Gt("u", -1),
+		Eq("s", 1),
+		Eq("d", math.NaN()),
+		In("a", 1, 2.5),
+		Or(Eq("a", 5), Eq("a", "5")),
+	}
+	for i, filter := range filters {
+		if _, err := f.FilterRowGroups(filter); err == nil {
+			t.Errorf("test %d: error expected", i)
+		}
+	}
+
+	if _, err := f.RowGroupMayMatch(3, IsNull("a")); err == nil {
+		t.Errorf("error expected for a non-existent row group")
+	}
+}
+
+func TestCompareSignedBytes(t *testing.T) {
+	tests := []struct {
+		a, b []byte
+		want int
+	}{
+		{[]byte{}, []byte{0}, 0},
+		{[]byte{1}, []byte{2}, -1},
+		{[]byte{0xff}, []byte{1}, -1},
+		{[]byte{0x80}, []byte{0xff}, -1},
+		{[]byte{0xff, 0xff}, []byte{0xff}, 0},
+		{[]byte{0x01, 0x00}, []byte{0x7f}, 1},
+		{[]byte{0xfe, 0x00}, []byte{0x80}, -1},
+		{[]byte{0x80}, []byte{0xff, 0x00}, 1},
+	}
+	for _, test := range tests {
+		if got := compareSignedBytes(test.a, test.b); got != test.want {
+			t.Errorf("compareSignedBytes(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -72,3 +72,23 @@ func ReadFileMetaData(r io.ReadSeeker) (*parquetformat.FileMetaData, error) {
 
 	return &meta, nil
 }
+
+// WriteFileMetaData writes meta to w followed by the length of the serialized
+// metadata and the parquet magic bytes. This is the last thing written to a
+// parquet file.
+func WriteFileMetaData(w io.Writer, meta *parquetformat.FileMetaData) error {
+	buf := new(bytes.Buffer)
+	if err := meta.Write(buf); err != nil {
+		return fmt.Errorf("Error serializing file metadata: %s", err)
+	}
+	footerLength := int32(buf.Len())
+	if err := binary.Write(buf, binary.LittleEndian, footerLength); err != nil {
+		return err
+	}
+	buf.Write(magic)
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("Error writing file metadata: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// ReadColumnIndex reads the column index (min/max statistics of every data
+// page) of the column chunk of col in row group rg. nil is returned if the
+// column chunk doesn't have a column index.
+func (f *File) ReadColumnIndex(col Column, rg int) (*parquetformat.ColumnIndex, error) {
+	chunk, err := f.columnChunk(col, rg)
+	if err != nil {
+		return nil, err
+	}
+	r, err := f.chunkReader(chunk)
+	if err != nil {
+		return nil, err
+	}
+	return readColumnIndex(r, chunk)
+}
+
+// ReadOffsetIndex reads the offset index (locations of data pages) of the
+// column chunk of col in row group rg. nil is returned if the column chunk
+// doesn't have an offset index.
+func (f *File) ReadOffsetIndex(col Column, rg int) (*parquetformat.OffsetIndex, error) {
+	chunk, err := f.columnChunk(col, rg)
+	if err != nil {
+		return nil, err
+	}
+	r, err := f.chunkReader(chunk)
+	if err != nil {
+		return nil, err
+	}
+	return readOffsetIndex(r, chunk)
+}
+
+// FilterPages returns indexes of data pages of the column chunk of col in row
+// group rg that may contain values matching filter according to the column
+// index of the column chunk. filter can reference only col.
+//
+// The rows stored in the returned pages can be found using the offset index of
+// the column chunk (see ReadOffsetIndex). ColumnChunkReader.SeekToPageWithRow
+// allows to read these rows from other columns.
+func (f *File) FilterPages(col Column, rg int, filter Filter) ([]int, error) {
+	ci, err := f.ReadColumnIndex(col, rg)
+	if err != nil {
+		return nil, err
+	}
+	if ci == nil {
+		return nil, fmt.Errorf("parquet: column %s has no column index in row group %d", col, rg)
+	}
+	matches, err := filterPages(col, ci, filter)
+	if err != nil {
+		return nil, err
+	}
+	var pages []int
+	for i, ok := range matches {
+		if ok {
+			pages = append(pages, i)
+		}
+	}
+	return pages, nil
+}
+
+// filterPages returns for every page described by ci whether it may contain
+// values of col matching filter.
+func filterPages(col Column, ci *parquetformat.ColumnIndex, filter Filter) ([]bool, error) {
+	matches := make([]bool, len(ci.NullPages))
+	for i := range matches {
+		ok, err := filter.mayMatch(func(name string) (*valueStats, error) {
+			if name != col.String() {
+				return nil, fmt.Errorf("parquet: page filter for column %s references column %s", col, name)
+			}
+			return pageStats(col, ci, i), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		matches[i] = ok
+	}
+	return matches, nil
+}
+
+func readColumnIndex(r io.ReaderAt, chunk *parquetformat.ColumnChunk) (*parquetformat.ColumnIndex, error) {
+	if chunk.ColumnIndexOffset == nil || chunk.ColumnIndexLength == nil {
+		return nil, nil
+	}
+	ci := &parquetformat.ColumnIndex{}
+	if err := readPageIndex(r, *chunk.ColumnIndexOffset, *chunk.ColumnIndexLength, ci.Read); err != nil {
+		return nil, fmt.Errorf("parquet: failed to read column index: %s", err)
+	}
+	n := len(ci.NullPages)
+	if len(ci.MinValues) != n || len(ci.MaxValues) != n || (ci.NullCounts != nil && len(ci.NullCounts) != n) {
+		return nil, errors.New("parquet: invalid column index: inconsistent number of pages")
+	}
+	return ci, nil
+}
+
+func readOffsetIndex(r io.ReaderAt, chunk *parquetformat.ColumnChunk) (*parquetformat.OffsetIndex, error) {
+	if chunk.OffsetIndexOffset == nil || chunk.OffsetIndexLength == nil {
+		return nil, nil
+	}
+	oi := &parquetformat.OffsetIndex{}
+	if err := readPageIndex(r, *chunk.OffsetIndexOffset, *chunk.OffsetIndexLength, oi.Read); err != nil {
+		return nil, fmt.Errorf("parquet: failed to read offset index: %s", err)
+	}
+	if len(oi.PageLocations) == 0 || oi.PageLocations[0].FirstRowIndex != 0 {
+		return nil, errors.New("parquet: invalid offset index: first page doesn't start at row 0")
+	}
+	return oi, nil
+}
+
+func readPageIndex(r io.ReaderAt, offset int64, length int32, read func(r io.Reader) error) error {
+	if offset < 0 || length < 0 {
+		return fmt.Errorf("invalid offset %d or length %d", offset, length)
+	}
+	return read(io.NewSectionReader(r, offset, int64(length)))
+}
+
+// ColumnIndex returns the column index of the column chunk or nil if the
+// column chunk doesn't have one. The column index is read on the first call.
+func (cr *ColumnChunkReader) ColumnIndex() (*parquetformat.ColumnIndex, error) {
+	if cr.columnIndex == nil {
+		ci, err := readColumnIndex(cr.reader.r, cr.chunk)
+		if err != nil {
+			return nil, err
+		}
+		cr.columnIndex = ci
+	}
+	return cr.columnIndex, nil
+}
+
+// OffsetIndex returns the offset index of the column chunk or nil if the
+// column chunk doesn't have one. The offset index is read on the first call.
+func (cr *ColumnChunkReader) OffsetIndex() (*parquetformat.OffsetIndex, error) {
+	if cr.offsetIndex == nil {
+		oi, err := readOffsetIndex(cr.reader.r, cr.chunk)
+		if err != nil {
+			return nil, err
+		}
+		cr.offsetIndex = oi
+	}
+	return cr.offsetIndex, nil
+}
+
+func (cr *ColumnChunkReader) requireOffsetIndex() (*parquetformat.OffsetIndex, error) {
+	oi, err := cr.OffsetIndex()
+	if err == nil && oi == nil {
+		err = fmt.Errorf("parquet: column chunk of %s has no offset index", cr.col)
+	}
+	return oi, err
+}
+
+// SeekToPage positions cr at the beginning of the i-th data page of the column
+// chunk (dictionary pages are not counted). The page is located using the
+// offset index of the column chunk.
+func (cr *ColumnChunkReader) SeekToPage(i int) error {
+	if cr.err != nil && cr.err != EndOfChunk {
+		return cr.err
+	}
+	oi, err := cr.requireOffsetIndex()
+	if err != nil {
+		return err
+	}
+	if i < 0 || i >= len(oi.PageLocations) {
+		return fmt.Errorf("parquet: no such page: %d", i)
+	}
+	cr.err = cr.seekToPage(i)
+	if cr.err != nil {
+		cr.page = nil
+	}
+	return cr.err
+}
+
+func (cr *ColumnChunkReader) seekToPage(i int) error {
+	loc := cr.offsetIndex.PageLocations[i]
+	if loc.Offset < cr.chunkOffset || loc.Offset-cr.chunkOffset >= cr.chunkMeta.TotalCompressedSize {
+		return fmt.Errorf("invalid offset of page %d: %d", i, loc.Offset)
+	}
+	cr.reader.offset = loc.Offset
+	cr.reader.n = loc.Offset - cr.chunkOffset
+	cr.pageOrdinal = i
+	cr.row = loc.FirstRowIndex
+	return cr.readPage(false)
+}
+
+// SeekToPageWithRow positions cr at the beginning of the data page that
+// contains row (0-based index of a row in the row group). It returns the index
+// of the first row stored in the page. The page is located using the offset
+// index of the column chunk.
+func (cr *ColumnChunkReader) SeekToPageWithRow(row int64) (int64, error) {
+	if row < 0 || row >= cr.numRows {
+		return 0, fmt.Errorf("parquet: row %d is out of range [0, %d)", row, cr.numRows)
+	}
+	oi, err := cr.requireOffsetIndex()
+	if err != nil {
+		return 0, err
+	}
+	locs := oi.PageLocations
+	i := sort.Search(len(locs), func(i int) bool { return locs[i].FirstRowIndex > row }) - 1
+	if err = cr.SeekToPage(i); err != nil {
+		return 0, err
+	}
+	return locs[i].FirstRowIndex, nil
+}
+
+// SetPageFilter makes cr skip data pages that cannot contain values matching
+// filter according to the column index of the column chunk. filter can
+// reference only the column of cr. If the current page doesn't match the
+// filter cr advances to the next matching page immediately.
+//
+// Pages are skipped independently in every column chunk, so readers of
+// different columns of the same row group are not aligned by rows anymore.
+// File.FilterPages together with SeekToPageWithRow can be used to read the
+// same rows from multiple columns.
+func (cr *ColumnChunkReader) SetPageFilter(filter Filter) error {
+	if cr.err != nil && cr.err != EndOfChunk {
+		return cr.err
+	}
+	ci, err := cr.ColumnIndex()
+	if err != nil {
+		return err
+	}
+	if ci == nil {
+		return fmt.Errorf("parquet: column chunk of %s has no column index", cr.col)
+	}
+	oi, err := cr.requireOffsetIndex()
+	if err != nil {
+		return err
+	}
+	if len(ci.NullPages) != len(oi.PageLocations) {
+		return errors.New("parquet: column index and offset index have different number of pages")
+	}
+	matches, err := filterPages(cr.col, ci, filter)
+	if err != nil {
+		return err
+	}
+	cr.pageMatches = matches
+
+	if cr.err == nil && (cr.pageOrdinal >= len(matches) || !matches[cr.pageOrdinal]) {
+		if err = cr.SkipPage(); err != nil && err != EndOfChunk {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func int64Stat(v int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+var pageIndexTestMeta = createFileMetaData(
+	&pf.SchemaElement{
+		Name:        "Test",
+		NumChildren: int32Ptr(2),
+	},
+	&pf.SchemaElement{
+		Name:           "id",
+		Type:           typeInt64,
+		RepetitionType: frtRequired,
+	},
+	&pf.SchemaElement{
+		Name:           "v",
+		Type:           typeInt32,
+		RepetitionType: frtOptional,
+	},
+)
+
+// createPageIndexTestFile writes a file with 10 rows (id = 0..9) in pages of 3
+// rows.
+func createPageIndexTestFile(t *testing.T) []byte {
+	schema := mustCreateSchema(pageIndexTestMeta)
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 3
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	var ids []int64
+	var vs []int32
+	var dLevels []uint16
+	for i := 0; i < 10; i++ {
+		ids = append(ids, int64(i))
+		vs = append(vs, int32(i*10))
+		dLevels = append(dLevels, 1)
+	}
+	if err = fw.WriteColumnChunk(schema.Columns()[0], ids, make([]uint16, 10), make([]uint16, 10)); err != nil {
+		t.Fatalf("failed to write id: %s", err)
+	}
+	if err = fw.WriteColumnChunk(schema.Columns()[1], vs, dLevels, make([]uint16, 10)); err != nil {
+		t.Fatalf("failed to write v: %s", err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func readAllInt64(cr *ColumnChunkReader) ([]int64, error) {
+	var all []int64
+	values := make([]int64, 2)
+	dLevels := make([]uint16, 2)
+	rLevels := make([]uint16, 2)
+	for {
+		n, err := cr.Read(values, dLevels, rLevels)
+		if err == EndOfChunk {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, values[:n]...)
+	}
+}
+
+func TestOffsetIndex(t *testing.T) {
+	f, err := FileFromReader(bytes.NewReader(createPageIndexTestFile(t)))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	col := f.Schema.Columns()[0]
+
+	oi, err := f.ReadOffsetIndex(col, 0)
+	if err != nil {
+		t.Fatalf("failed to read offset index: %s", err)
+	}
+	var firstRows []int64
+	for _, loc := range oi.PageLocations {
+		firstRows = append(firstRows, loc.FirstRowIndex)
+	}
+	if want := []int64{0, 3, 6, 9}; !reflect.DeepEqual(firstRows, want) {
+		t.Errorf("got first rows %v, want %v", firstRows, want)
+	}
+	cr, err := f.NewReader(col, 0)
+	if err != nil {
+		t.Fatalf("failed to create reader: %s", err)
+	}
+	first, err := cr.SeekToPageWithRow(7)
+	if err != nil {
+		t.Fatalf("failed to seek to row 7: %s", err)
+	}
+	if first != 6 {
+		t.Errorf("page with row 7 starts at row %d, want 6", first)
+	}
+	values, err := readAllInt64(cr)
+	if err != nil {
+		t.Fatalf("failed to read: %s", err)
+	}
+	if want := []int64{6, 7, 8, 9}; !reflect.DeepEqual(values, want) {
+		t.Errorf("got %v, want %v", values, want)
+	}
+
+	// seeking works after the end of the column chunk has been reached
+	if err = cr.SeekToPage(1); err != nil {
+		t.Fatalf("failed to seek to page 1: %s", err)
+	}
+	values, err = readAllInt64(cr)
+	if err != nil {
+		t.Fatalf("failed to read: %s", err)
+	}
+	if want := []int64{3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(values, want) {
+		t.Errorf("got %v, want %v", values, want)
+	}
+
+	if err = cr.SeekToPage(4); err == nil {
+		t.Errorf("error expected for a non-existent page")
+	}
+	if _, err = cr.SeekToPageWithRow(10); err == nil {
+		t.Errorf("error expected for a non-existent row")
+	}
+}
+
+func TestPageFilter(t *testing.T) {
+	f, err := FileFromReader(bytes.NewReader(createPageIndexTestFile(t)))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	col := f.Schema.Columns()[0]
+	wantCI := &pf.ColumnIndex{
+		NullPages:     []bool{false, false, false, false},
+		MinValues:     [][]byte{int64Stat(0), int64Stat(3), int64Stat(6), int64Stat(9)},
+		MaxValues:     [][]byte{int64Stat(2), int64Stat(5), int64Stat(8), int64Stat(9)},
+		BoundaryOrder: pf.BoundaryOrder_ASCENDING,
+		NullCounts:    []int64{0, 0, 0, 0},
+	}
+	if ci, err := f.ReadColumnIndex(col, 0); err != nil || !reflect.DeepEqual(ci, wantCI) {
+		t.Errorf("got column index %v (err = %v), want %v", ci, err, wantCI)
+	}
+
+	tests := []struct {
+		filter Filter
+		pages  []int
+		values []int64
+	}{
+		{Or(Lt("id", 2), Eq("id", 7)), []int{0, 2}, []int64{0, 1, 2, 6, 7, 8}},
+		{Gt("id", 4), []int{1, 2, 3}, []int64{3, 4, 5, 6, 7, 8, 9}},
+		{Eq("id", 9), []int{3}, []int64{9}},
+		{Lt("id", 0), nil, nil},
+		{Not(IsNull("id")), []int{0, 1, 2, 3}, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+	}
+	for i, test := range tests {
+		pages, err := f.FilterPages(col, 0, test.filter)
+		if err != nil {
+			t.Errorf("test %d: failed to filter pages: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(pages, test.pages) {
+			t.Errorf("test %d: got pages %v, want %v", i, pages, test.pages)
+		}
+
+		cr, err := f.NewReader(col, 0)
+		if err != nil {
+			t.Fatalf("failed to create reader: %s", err)
+		}
+		if err = cr.SetPageFilter(test.filter); err != nil {
+			t.Errorf("test %d: failed to set page filter: %s", i, err)
+			continue
+		}
+		values, err := readAllInt64(cr)
+		if err != nil {
+			t.Errorf("test %d: failed to read: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(values, test.values) {
+			t.Errorf("test %d: got values %v, want %v", i, values, test.values)
+		}
+	}
+
+	if _, err = f.FilterPages(col, 0, Eq("v", 1)); err == nil {
+		t.Errorf("error expected for a filter referencing another column")
+	}
+	f.MetaData.RowGroups[0].Columns[1].ColumnIndexOffset = nil
+	if _, err = f.FilterPages(f.Schema.Columns()[1], 0, Eq("v", 1)); err == nil {
+		t.Errorf("error expected for a column chunk without column index")
+	}
+}
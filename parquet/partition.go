@@ -0,0 +1,174 @@
+package parquet
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// hiveNullPartition is the value used by Hive (and Spark) for partitions of
+// rows with null partition column values.
+const hiveNullPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// parsePartitionPath returns keys and unescaped values of key=value segments
+// of directories in path ("/" separated path of a data file relative to the
+// dataset directory). A value is nil for the null partition.
+func parsePartitionPath(path string) (keys []string, values []*string, err error) {
+	segments := strings.Split(path, "/")
+	for _, seg := range segments[:len(segments)-1] {
+		i := strings.IndexByte(seg, '=')
+		if i <= 0 {
+			continue
+		}
+		key, err := url.PathUnescape(seg[:i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid partition key in %s: %s", path, err)
+		}
+		value, err := url.PathUnescape(seg[i+1:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid partition value in %s: %s", path, err)
+		}
+		keys = append(keys, key)
+		if value == hiveNullPartition {
+			values = append(values, nil)
+		} else {
+			values = append(values, &value)
+		}
+	}
+	return keys, values, nil
+}
+
+// partitioning holds values of partition columns of all files of a dataset.
+type partitioning struct {
+	columns []Column
+	values  [][]interface{} // per file
+}
+
+// discoverPartitions parses Hive style partition directories (key=value) in
+// paths of data files. All files must have the same partition keys. Types of
+// partition columns are inferred from values: INT64 if all values are
+// integers, DOUBLE if all values are numbers, otherwise BYTE_ARRAY (UTF8).
+// Partition columns are numbered from 0, Dataset renumbers them to follow
+// columns of its schema.
+func discoverPartitions(paths []string) (*partitioning, error) {
+	var keys []string
+	raw := make([][]*string, len(paths))
+	for i, path := range paths {
+		k, v, err := parsePartitionPath(path)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			keys = k
+		} else if strings.Join(k, "/") != strings.Join(keys, "/") {
+			return nil, fmt.Errorf("partition keys of %s (%s) are different from partition keys of %s (%s)",
+				path, strings.Join(k, ", "), paths[0], strings.Join(keys, ", "))
+		}
+		raw[i] = v
+	}
+
+	p := &partitioning{values: make([][]interface{}, len(paths))}
+	for i := range p.values {
+		p.values[i] = make([]interface{}, len(keys))
+	}
+	for k, key := range keys {
+		for _, other := range keys[:k] {
+			if other == key {
+				return nil, fmt.Errorf("duplicate partition key %s", key)
+			}
+		}
+		t := inferPartitionType(raw, k)
+		se := &parquetformat.SchemaElement{
+			Name:           key,
+			Type:           &t,
+			RepetitionType: parquetformat.FieldRepetitionTypePtr(parquetformat.FieldRepetitionType_OPTIONAL),
+		}
+		if t == parquetformat.Type_BYTE_ARRAY {
+			se.ConvertedType = parquetformat.ConvertedTypePtr(parquetformat.ConvertedType_UTF8)
+		}
+		p.columns = append(p.columns, Column{index: k, name: key, path: &[]string{key}, maxD: 1, schemaElement: se})
+
+		for i, values := range raw {
+			if values[k] == nil {
+				continue
+			}
+			s := *values[k]
+			switch t {
+			case parquetformat.Type_INT64:
+				p.values[i][k], _ = strconv.ParseInt(s, 10, 64)
+			case parquetformat.Type_DOUBLE:
+				p.values[i][k], _ = strconv.ParseFloat(s, 64)
+			default:
+				p.values[i][k] = []byte(s)
+			}
+		}
+	}
+	return p, nil
+}
+
+// inferPartitionType returns the type of k-th partition column.
+func inferPartitionType(raw [][]*string, k int) parquetformat.Type {
+	ints, floats := true, true
+	for _, values := range raw {
+		if values[k] == nil {
+			continue
+		}
+		s := *values[k]
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			ints = false
+		}
+		if f, err := strconv.ParseFloat(s, 64); err != nil || f != f {
+			floats = false
+		}
+	}
+	switch {
+	case ints:
+		return parquetformat.Type_INT64
+	case floats:
+		return parquetformat.Type_DOUBLE
+	}
+	return parquetformat.Type_BYTE_ARRAY
+}
+
+// partitionStatsFunc returns a statsFunc that returns statistics of partition
+// columns cols with the given values and uses other for all other columns.
+func partitionStatsFunc(cols []Column, values []interface{}, other statsFunc) statsFunc {
+	return func(name string) (*valueStats, error) {
+		for i, col := range cols {
+			if col.name == name {
+				return partitionStats(col, values[i]), nil
+			}
+		}
+		return other(name)
+	}
+}
+
+// partitionStats returns statistics of a single partition column with
+// the given value (nil for null).
+func partitionStats(col Column, value interface{}) *valueStats {
+	s := newValueStats(col)
+	s.numValues = 1
+	if value == nil {
+		s.nullCount = 1
+	} else {
+		s.nullCount = 0
+		s.min, s.max = value, value
+	}
+	return s
+}
+
+// unknownStats returns statistics of a column about which nothing is known
+// (e.g. a column of a file that hasn't been opened yet). Any filter value is
+// accepted.
+func unknownStats(column string) (*valueStats, error) {
+	return &valueStats{
+		nullCount: -1,
+		numValues: -1,
+		convert: func(v interface{}) (interface{}, error) {
+			return v, nil
+		},
+	}, nil
+}
@@ -1,7 +1,6 @@
 package parquet
 
 import (
-	"compress/gzip"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -9,9 +8,7 @@ import (
 	"io/ioutil"
 	"math/bits"
 	"reflect"
-	"strings"
 
-	"github.com/golang/snappy"
 	"github.com/kostya-sh/parquet-go/parquetformat"
 )
 
@@ -19,6 +16,9 @@ var (
 	EndOfChunk = errors.New("EndOfChunk")
 )
 
+// number of levels decoded at a time when rows are skipped
+const skipBatchSize = 1024
+
 // ColumnChunkReader allows to read data from a single column chunk of a parquet
 // file.
 type ColumnChunkReader struct {
@@ -28,23 +28,44 @@ type ColumnChunkReader struct {
 	meta   *parquetformat.FileMetaData
 
 	err            error
+	chunk          *parquetformat.ColumnChunk
 	chunkMeta      *parquetformat.ColumnMetaData
+	chunkOffset    int64
+	numRows        int64
 	page           *parquetformat.PageHeader
 	dictPage       *parquetformat.PageHeader
+	pageOrdinal    int // index of the current data page in the column chunk
 	readPageValues int
 	pageNumValues  int
 
+	// row is the number of rows started before the current position or -1 if
+	// unknown
+	row int64
+
+	// location of the first data page (used to rewind the reader)
+	firstPageOffset int64
+	firstPageN      int64
+
+	// levels of the current page that have been decoded but not returned yet
+	// (values of these levels have not been decoded)
+	pendingD []uint16
+	pendingR []uint16
+	levelsD  []uint16
+	levelsR  []uint16
+	skipBuf  interface{}
+
+	// page index (loaded on demand)
+	columnIndex *parquetformat.ColumnIndex
+	offsetIndex *parquetformat.OffsetIndex
+	pageMatches []bool // pages that may match the page filter
+
 	valuesDecoder     valuesDecoder
 	dictValuesDecoder dictValuesDecoder
 	dDecoder          levelsDecoder
 	rDecoder          levelsDecoder
 }
 
-func newColumnChunkReader(r io.ReadSeeker, meta *parquetformat.FileMetaData, col Column, chunk *parquetformat.ColumnChunk) (*ColumnChunkReader, error) {
-	if chunk.FilePath != nil {
-		return nil, fmt.Errorf("nyi: data is in another file: '%s'", *chunk.FilePath)
-	}
-
+func newColumnChunkReader(r io.ReaderAt, meta *parquetformat.FileMetaData, col Column, chunk *parquetformat.ColumnChunk, numRows int64) (*ColumnChunkReader, error) {
 	c := col.Index()
 	// chunk.FileOffset is useless so ChunkMetaData is required here
 	// as we cannot read it from r
@@ -63,29 +84,28 @@ func newColumnChunkReader(r io.ReadSeeker, meta *parquetformat.FileMetaData, col
 		offset = *chunk.MetaData.DictionaryPageOffset
 	}
 	cr := &ColumnChunkReader{
-		col:       col,
-		reader:    &countingReader{rs: r, offset: offset},
-		meta:      meta,
-		chunkMeta: chunk.MetaData,
-	}
-
-	nested := strings.IndexByte(col.name, '.') >= 0
-	repType := *col.schemaElement.RepetitionType
-	if !nested && repType == parquetformat.FieldRepetitionType_REQUIRED {
-		// TODO: also check that len(Path) = maxD
-		// For data that is required, the definition levels are not encoded and
-		// always have the value of the max definition level.
+		col:         col,
+		reader:      &countingReader{r: r, offset: offset},
+		meta:        meta,
+		chunk:       chunk,
+		chunkMeta:   chunk.MetaData,
+		chunkOffset: offset,
+		numRows:     numRows,
+	}
+
+	if col.maxD == 0 {
+		// For data that is required (including all its parents), the
+		// definition levels are not encoded and always have the value of the
+		// max definition level.
 		cr.dDecoder = constDecoder(col.maxD)
 		// TODO: document level ranges
 	} else {
 		cr.dDecoder = newRLEDecoder(bits.Len16(col.maxD))
 	}
-	if !nested && repType != parquetformat.FieldRepetitionType_REPEATED {
-		// TODO: I think we need to check all schemaElements in the path (confirm if above)
+	if col.maxR == 0 {
+		// Repetition levels are not encoded when the maximum repetition level
+		// is 0 (i.e. there are no repeated fields in the column path).
 		cr.rDecoder = constDecoder(0)
-		// TODO: clarify the following comment from parquet-format/README:
-		// If the column is not nested the repetition levels are not encoded and
-		// always have the value of 1
 	} else {
 		cr.rDecoder = newRLEDecoder(bits.Len16(col.maxR))
 	}
@@ -126,6 +146,8 @@ func (cr *ColumnChunkReader) newValuesDecoder(pageEncoding parquetformat.Encodin
 		switch pageEncoding {
 		case parquetformat.Encoding_PLAIN:
 			return &byteArrayPlainDecoder{length: int(*cr.col.schemaElement.TypeLength)}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newByteArrayByteStreamSplitDecoder(int(*cr.col.schemaElement.TypeLength)), nil
 		case parquetformat.Encoding_DELTA_BYTE_ARRAY:
 			return &byteArrayDeltaDecoder{}, nil
 		case parquetformat.Encoding_RLE_DICTIONARY:
@@ -136,6 +158,8 @@ func (cr *ColumnChunkReader) newValuesDecoder(pageEncoding parquetformat.Encodin
 		switch pageEncoding {
 		case parquetformat.Encoding_PLAIN:
 			return &floatPlainDecoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newFloatByteStreamSplitDecoder(), nil
 		case parquetformat.Encoding_RLE_DICTIONARY:
 			return cr.dictValuesDecoder, nil
 		}
@@ -144,6 +168,8 @@ func (cr *ColumnChunkReader) newValuesDecoder(pageEncoding parquetformat.Encodin
 		switch pageEncoding {
 		case parquetformat.Encoding_PLAIN:
 			return &doublePlainDecoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newDoubleByteStreamSplitDecoder(), nil
 		case parquetformat.Encoding_RLE_DICTIONARY:
 			return cr.dictValuesDecoder, nil
 		}
@@ -152,6 +178,8 @@ func (cr *ColumnChunkReader) newValuesDecoder(pageEncoding parquetformat.Encodin
 		switch pageEncoding {
 		case parquetformat.Encoding_PLAIN:
 			return &int32PlainDecoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newInt32ByteStreamSplitDecoder(), nil
 		case parquetformat.Encoding_DELTA_BINARY_PACKED:
 			return &int32DeltaBinaryPackedDecoder{}, nil
 		case parquetformat.Encoding_RLE_DICTIONARY:
@@ -162,6 +190,8 @@ func (cr *ColumnChunkReader) newValuesDecoder(pageEncoding parquetformat.Encodin
 		switch pageEncoding {
 		case parquetformat.Encoding_PLAIN:
 			return &int64PlainDecoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newInt64ByteStreamSplitDecoder(), nil
 		case parquetformat.Encoding_DELTA_BINARY_PACKED:
 			return &int64DeltaBinaryPackedDecoder{}, nil
 		case parquetformat.Encoding_RLE_DICTIONARY:
@@ -256,36 +286,16 @@ func (cr *ColumnChunkReader) readPageData(compressedSize int32, uncompressedSize
 	if compressedSize < 0 || uncompressedSize < 0 {
 		return nil, errors.New("invalid page data size")
 	}
-	r := io.LimitReader(cr.reader, int64(compressedSize))
-
-	codec := cr.chunkMeta.Codec
-	switch codec {
-	case parquetformat.CompressionCodec_SNAPPY:
-		// decompress after reading:
-		// parquet uses snappy block encoding (snappy.Reader is for streaming encoing)
-	case parquetformat.CompressionCodec_UNCOMPRESSED:
-		// do nothing
-	case parquetformat.CompressionCodec_GZIP:
-		r, err = gzip.NewReader(r)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, fmt.Errorf("unsupported compression codec: %s", codec)
+	if cr.reader.n+int64(compressedSize) > cr.chunkMeta.TotalCompressedSize {
+		return nil, errors.New("over-read")
 	}
-
-	data, err = ioutil.ReadAll(r)
-	if err != nil {
+	data = make([]byte, compressedSize)
+	if _, err = io.ReadFull(cr.reader, data); err != nil {
 		return nil, err
 	}
-	if cr.reader.n > cr.chunkMeta.TotalCompressedSize {
-		return nil, errors.New("over-read")
-	}
-	if codec == parquetformat.CompressionCodec_SNAPPY {
-		data, err = snappy.Decode(nil, data)
-		if err != nil {
-			return nil, err
-		}
+	data, err = decompressPageData(cr.chunkMeta.Codec, data, int(uncompressedSize))
+	if err != nil {
+		return nil, err
 	}
 	if len(data) != int(uncompressedSize) {
 		return nil, errors.New("page data after uncompression is incomplete")
@@ -301,37 +311,66 @@ func (cr *ColumnChunkReader) readPageDataV1(ph *parquetformat.PageHeader, dph *p
 
 	// TODO: it looks like parquetformat README is incorrect: first R then D
 	if _, isConst := cr.rDecoder.(constDecoder); !isConst {
-		if enc := dph.RepetitionLevelEncoding; enc != parquetformat.Encoding_RLE {
+		enc := dph.RepetitionLevelEncoding
+		if !setLevelsDecoder(&cr.rDecoder, cr.col.maxR, enc) {
 			return nil, nil, nil, fmt.Errorf("%s RepetitionLevelEncoding is not supported", enc)
 		}
-		if len(data) < 4 {
-			return nil, nil, nil, errors.New("not enough data to read repetition levels")
-		}
-		n := int(binary.LittleEndian.Uint32(data[:4]))
-		if n < 0 || uint(n+4) > uint(len(data)) {
-			return nil, nil, nil, errors.New("invalid repetition levels data length")
+		if rData, data, err = splitLevelsV1(data, enc, int(dph.NumValues), cr.col.maxR); err != nil {
+			return nil, nil, nil, fmt.Errorf("repetition levels: %s", err)
 		}
-		rData = data[4 : 4+n]
-		data = data[4+n:]
 	}
 	if _, isConst := cr.dDecoder.(constDecoder); !isConst {
-		if enc := dph.DefinitionLevelEncoding; enc != parquetformat.Encoding_RLE {
+		enc := dph.DefinitionLevelEncoding
+		if !setLevelsDecoder(&cr.dDecoder, cr.col.maxD, enc) {
 			return nil, nil, nil, fmt.Errorf("%s DefinitionLevelEncoding is not supported", enc)
 		}
-		if len(data) < 4 {
-			return nil, nil, nil, errors.New("not enough data to read definition levels")
-		}
-		n := int(binary.LittleEndian.Uint32(data[:4]))
-		if n < 0 || uint(n+4) > uint(len(data)) {
-			return nil, nil, nil, errors.New("invalid definition levels data length")
+		if dData, data, err = splitLevelsV1(data, enc, int(dph.NumValues), cr.col.maxD); err != nil {
+			return nil, nil, nil, fmt.Errorf("definition levels: %s", err)
 		}
-		dData = data[4 : 4+n]
-		data = data[4+n:]
 	}
 
 	return data, dData, rData, nil
 }
 
+// splitLevelsV1 splits data of a v1 page into levels data encoded with enc and
+// the rest of the page. RLE encoded levels are prefixed with their length,
+// the length of BIT_PACKED levels is derived from the number of values.
+func splitLevelsV1(data []byte, enc parquetformat.Encoding, numValues int, maxLevel uint16) (levels []byte, rest []byte, err error) {
+	var n int
+	if enc == parquetformat.Encoding_BIT_PACKED {
+		n = bitPackedLevelsSize(numValues, bits.Len16(maxLevel))
+	} else {
+		if len(data) < 4 {
+			return nil, nil, errors.New("not enough data to read levels length")
+		}
+		n = int(binary.LittleEndian.Uint32(data[:4]))
+		data = data[4:]
+	}
+	if n < 0 || n > len(data) {
+		return nil, nil, errors.New("invalid levels data length")
+	}
+	return data[:n], data[n:], nil
+}
+
+// setLevelsDecoder makes *d a decoder of levels in range [0, maxLevel]
+// encoded with enc. The decoder is replaced only if the encoding differs from
+// the one used by *d. It returns false if enc is not supported.
+func setLevelsDecoder(d *levelsDecoder, maxLevel uint16, enc parquetformat.Encoding) bool {
+	switch enc {
+	case parquetformat.Encoding_RLE:
+		if _, ok := (*d).(*rleDecoder); !ok {
+			*d = newRLEDecoder(bits.Len16(maxLevel))
+		}
+	case parquetformat.Encoding_BIT_PACKED:
+		if _, ok := (*d).(*bitPackedDecoder); !ok {
+			*d = newBitPackedDecoder(bits.Len16(maxLevel))
+		}
+	default:
+		return false
+	}
+	return true
+}
+
 func (cr *ColumnChunkReader) readPageDataV2(ph *parquetformat.PageHeader, dph *parquetformat.DataPageHeaderV2) (valuesData, dData, rData []byte, err error) {
 	if dph.RepetitionLevelsByteLength < 0 {
 		return nil, nil, nil, fmt.Errorf("invalid RepetitionLevelsByteLength")
@@ -351,6 +390,7 @@ func (cr *ColumnChunkReader) readPageDataV2(ph *parquetformat.PageHeader, dph *p
 		return nil, nil, nil, errors.New("unable to read all levels data")
 	}
 	if _, isConst := cr.rDecoder.(constDecoder); !isConst {
+		setLevelsDecoder(&cr.rDecoder, cr.col.maxR, parquetformat.Encoding_RLE)
 		n := int(dph.RepetitionLevelsByteLength)
 		rData = levelsData[:n]
 		levelsData = levelsData[n:]
@@ -360,6 +400,7 @@ func (cr *ColumnChunkReader) readPageDataV2(ph *parquetformat.PageHeader, dph *p
 		}
 	}
 	if _, isConst := cr.dDecoder.(constDecoder); !isConst {
+		setLevelsDecoder(&cr.dDecoder, cr.col.maxD, parquetformat.Encoding_RLE)
 		dData = levelsData
 	} else {
 		if dph.DefinitionLevelsByteLength != 0 {
@@ -376,8 +417,8 @@ func (cr *ColumnChunkReader) readPageDataV2(ph *parquetformat.PageHeader, dph *p
 }
 
 func (cr *ColumnChunkReader) readPage(first bool) error {
-	if _, err := cr.reader.SeekToOffset(); err != nil {
-		return err
+	if first {
+		cr.firstPageOffset, cr.firstPageN = cr.reader.offset, cr.reader.n
 	}
 
 	ph := &parquetformat.PageHeader{}
@@ -412,10 +453,8 @@ func (cr *ColumnChunkReader) readPage(first bool) error {
 
 		if cr.chunkMeta.DictionaryPageOffset != nil {
 			cr.reader.offset = cr.chunkMeta.DataPageOffset
-			if _, err = cr.reader.SeekToOffset(); err != nil {
-				return err
-			}
 		}
+		cr.firstPageOffset, cr.firstPageN = cr.reader.offset, cr.reader.n
 		ph = &parquetformat.PageHeader{}
 		if err = ph.Read(cr.reader); err != nil {
 			return err
@@ -488,12 +527,25 @@ func (cr *ColumnChunkReader) readPage(first bool) error {
 	cr.page = ph
 	cr.readPageValues = 0
 	cr.pageNumValues = numValues
+	cr.pendingD, cr.pendingR = nil, nil
 
 	return nil
 }
 
+// pageNumRows returns the number of rows in the current page or -1 if it is
+// unknown without decoding repetition levels.
+func (cr *ColumnChunkReader) pageNumRows() int64 {
+	switch {
+	case cr.page.DataPageHeaderV2 != nil:
+		return int64(cr.page.DataPageHeaderV2.NumRows)
+	case cr.col.MaxR() == 0:
+		return int64(cr.pageNumValues)
+	}
+	return -1
+}
+
 // Read reads up to len(dLevels) values into values and corresponding definition
-// and repetition levels into dLevels and rLevels respectfully. Panics if
+// and repetition levels into dLevels and rLevels respectively. Panics if
 // len(dLevels) != len(rLevels) != len(values). It returns the number of values
 // read (including nulls) and any errors encountered.
 //
@@ -516,16 +568,28 @@ func (cr *ColumnChunkReader) Read(values interface{}, dLevels []uint16, rLevels
 		return 0, cr.err
 	}
 
-	// read levels
+	// read levels (starting with the levels decoded by SkipRows)
 	batchSize := len(dLevels)
 	if rem := cr.pageNumValues - cr.readPageValues; rem < batchSize {
 		batchSize = rem
 	}
-	if err := cr.dDecoder.decodeLevels(dLevels[:batchSize]); err != nil {
-		return n, fmt.Errorf("failed to read definition levels: %s", err)
+	k := copy(dLevels[:batchSize], cr.pendingD)
+	copy(rLevels[:k], cr.pendingR)
+	cr.pendingD, cr.pendingR = cr.pendingD[k:], cr.pendingR[k:]
+	if k < batchSize {
+		if err := cr.dDecoder.decodeLevels(dLevels[k:batchSize]); err != nil {
+			return n, fmt.Errorf("failed to read definition levels: %s", err)
+		}
+		if err := cr.rDecoder.decodeLevels(rLevels[k:batchSize]); err != nil {
+			return n, fmt.Errorf("failed to read repetition levels: %s", err)
+		}
 	}
-	if err := cr.rDecoder.decodeLevels(rLevels[:batchSize]); err != nil {
-		return n, fmt.Errorf("failed to read repetition levels: %s", err)
+	if cr.row >= 0 {
+		for _, lr := range rLevels[:batchSize] {
+			if lr == 0 {
+				cr.row++
+			}
+		}
 	}
 
 	// read values
@@ -558,17 +622,40 @@ func (cr *ColumnChunkReader) Read(values interface{}, dLevels []uint16, rLevels
 }
 
 // SkipPage positions cr at the beginning of the next page skipping all values
-// in the current page.
+// in the current page. If a page filter is set (see SetPageFilter) pages that
+// cannot contain matching values are skipped as well.
 //
 // Returns EndOfChunk if no more data available
 func (cr *ColumnChunkReader) SkipPage() error {
 	if cr.err != nil {
 		return cr.err
 	}
-	if cr.reader.n == cr.chunkMeta.TotalCompressedSize { // TODO: maybe use chunkMeta.NumValues
+	if rem := cr.pageNumValues - cr.readPageValues; rem > 0 && cr.row >= 0 {
+		switch {
+		case cr.readPageValues == 0 && cr.pageNumRows() >= 0:
+			cr.row += cr.pageNumRows()
+		case cr.col.MaxR() == 0:
+			cr.row += int64(rem)
+		default:
+			cr.row = -1
+		}
+	}
+	switch {
+	case cr.pageMatches != nil:
+		next := cr.pageOrdinal + 1
+		for next < len(cr.pageMatches) && !cr.pageMatches[next] {
+			next++
+		}
+		if next == len(cr.pageMatches) {
+			cr.err = EndOfChunk
+		} else {
+			cr.err = cr.seekToPage(next)
+		}
+	case cr.reader.n == cr.chunkMeta.TotalCompressedSize: // TODO: maybe use chunkMeta.NumValues
 		cr.err = EndOfChunk
-	} else {
+	default:
 		// TODO: read data lazily only if Read is called
+		cr.pageOrdinal++
 		cr.err = cr.readPage(false)
 	}
 	if cr.err != nil {
@@ -577,6 +664,164 @@ func (cr *ColumnChunkReader) SkipPage() error {
 	return cr.err
 }
 
+// SkipRows skips the next n rows (top-level records). If the current row has
+// been partially read the rest of its values are skipped as well, i.e.
+// SkipRows(0) skips to the beginning of the next row in this case.
+//
+// Whole pages are skipped without decompressing their data if the number of
+// rows in a page is known from its header (DATA_PAGE_V2 pages or columns
+// without repeated fields). Otherwise levels are decoded to find row
+// boundaries but values are not materialized.
+//
+// Returns EndOfChunk if the column chunk has less than n remaining rows.
+func (cr *ColumnChunkReader) SkipRows(n int64) error {
+	if n < 0 {
+		return fmt.Errorf("parquet: invalid number of rows to skip: %d", n)
+	}
+	for {
+		if cr.err == EndOfChunk && n == 0 {
+			return nil
+		}
+		if cr.err != nil {
+			return cr.err
+		}
+
+		if n > 0 && cr.readPageValues == 0 {
+			if rows := cr.pageNumRows(); rows >= 0 && rows <= n {
+				n -= rows
+				_ = cr.SkipPage()
+				continue
+			}
+		}
+
+		if len(cr.pendingD) == 0 {
+			if err := cr.decodePendingLevels(); err != nil {
+				cr.err = err
+				return err
+			}
+		}
+
+		// consume levels up to the beginning of the row that follows the
+		// skipped rows
+		i, nn := 0, 0
+		for ; i < len(cr.pendingR); i++ {
+			if cr.pendingR[i] == 0 {
+				if n == 0 {
+					break
+				}
+				n--
+				if cr.row >= 0 {
+					cr.row++
+				}
+			}
+			if cr.pendingD[i] == cr.col.MaxD() {
+				nn++
+			}
+		}
+		if nn > 0 {
+			if err := cr.skipValues(nn); err != nil {
+				cr.err = fmt.Errorf("failed to skip values: %s", err)
+				return cr.err
+			}
+		}
+		stop := i < len(cr.pendingR)
+		cr.pendingD, cr.pendingR = cr.pendingD[i:], cr.pendingR[i:]
+		cr.readPageValues += i
+		if stop {
+			return nil
+		}
+		if cr.readPageValues == cr.pageNumValues {
+			_ = cr.SkipPage()
+		}
+	}
+}
+
+// decodePendingLevels decodes the next batch of levels of the current page to
+// be consumed by SkipRows or returned by Read.
+func (cr *ColumnChunkReader) decodePendingLevels() error {
+	if cr.levelsD == nil {
+		cr.levelsD = make([]uint16, skipBatchSize)
+		cr.levelsR = make([]uint16, skipBatchSize)
+	}
+	n := cr.pageNumValues - cr.readPageValues
+	if n > skipBatchSize {
+		n = skipBatchSize
+	}
+	if err := cr.dDecoder.decodeLevels(cr.levelsD[:n]); err != nil {
+		return fmt.Errorf("failed to read definition levels: %s", err)
+	}
+	if err := cr.rDecoder.decodeLevels(cr.levelsR[:n]); err != nil {
+		return fmt.Errorf("failed to read repetition levels: %s", err)
+	}
+	cr.pendingD, cr.pendingR = cr.levelsD[:n], cr.levelsR[:n]
+	return nil
+}
+
+// skipValues skips n values of the current page. Values are decoded into a
+// temporary buffer if the values decoder cannot skip them.
+func (cr *ColumnChunkReader) skipValues(n int) error {
+	if s, ok := cr.valuesDecoder.(valuesSkipper); ok {
+		return s.skip(n)
+	}
+	if cr.skipBuf == nil {
+		cr.skipBuf = newTypedValues(cr.col, skipBatchSize)
+	}
+	buf := reflect.ValueOf(cr.skipBuf)
+	for n > 0 {
+		k := n
+		if k > skipBatchSize {
+			k = skipBatchSize
+		}
+		if err := cr.valuesDecoder.decode(buf.Slice(0, k).Interface()); err != nil {
+			return err
+		}
+		n -= k
+	}
+	return nil
+}
+
+// SeekToRow positions cr at the beginning of row (0-based index of a row in
+// the row group).
+//
+// If the column chunk has an offset index it is used to find the page that
+// contains the row. Otherwise rows are skipped from the current position (or
+// from the beginning of the column chunk if the row is before the current
+// position). SeekToRow should not be used together with a page filter.
+func (cr *ColumnChunkReader) SeekToRow(row int64) error {
+	if row < 0 || row >= cr.numRows {
+		return fmt.Errorf("parquet: row %d is out of range [0, %d)", row, cr.numRows)
+	}
+	if cr.err != nil && cr.err != EndOfChunk {
+		return cr.err
+	}
+	oi, err := cr.OffsetIndex()
+	if err != nil {
+		return err
+	}
+	switch {
+	case oi != nil:
+		if _, err = cr.SeekToPageWithRow(row); err != nil {
+			return err
+		}
+	case cr.row < 0 || cr.row > row:
+		cr.err = cr.rewind()
+		if cr.err != nil {
+			cr.page = nil
+			return cr.err
+		}
+	}
+	return cr.SkipRows(row - cr.row)
+}
+
+// rewind positions cr at the beginning of the first data page.
+func (cr *ColumnChunkReader) rewind() error {
+	cr.reader.offset = cr.firstPageOffset
+	cr.reader.n = cr.firstPageN
+	cr.pageOrdinal = 0
+	cr.row = 0
+	return cr.readPage(false)
+}
+
 // PageHeader returns PageHeader of a page that is about to be read or
 // currently being read.
 //
@@ -604,19 +849,20 @@ func (d constDecoder) decodeLevels(dst []uint16) error {
 	return nil
 }
 
+// countingReader reads r sequentially starting at offset. It doesn't share
+// any state with other readers of r.
 type countingReader struct {
-	rs     io.ReadSeeker
+	r      io.ReaderAt
 	n      int64
 	offset int64
 }
 
 func (r *countingReader) Read(p []byte) (n int, err error) {
-	n, err = r.rs.Read(p)
+	n, err = r.r.ReadAt(p, r.offset)
 	r.n += int64(n)
 	r.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
 	return
 }
-
-func (r *countingReader) SeekToOffset() (n int64, err error) {
-	return r.rs.Seek(r.offset, io.SeekStart)
-}
@@ -1,6 +1,8 @@
 package parquet
 
 import (
+	"bytes"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -256,3 +258,314 @@ func TestInvalidData(t *testing.T) {
 	}
 
 }
+
+// splitRows splits cells of a column chunk into rows.
+func splitRows(cells []cell) [][]cell {
+	var rows [][]cell
+	for _, c := range cells {
+		if c.r == 0 {
+			rows = append(rows, nil)
+		}
+		rows[len(rows)-1] = append(rows[len(rows)-1], c)
+	}
+	return rows
+}
+
+func flattenRows(rows [][]cell) []cell {
+	var cells []cell
+	for _, row := range rows {
+		cells = append(cells, row...)
+	}
+	return cells
+}
+
+// readCellsFrom reads up to max cells (all remaining cells if max < 0) from
+// cr.
+func readCellsFrom(cr *ColumnChunkReader, col Column, max int) ([]cell, error) {
+	var cells []cell
+	values := make([]interface{}, 3)
+	dLevels := make([]uint16, 3)
+	rLevels := make([]uint16, 3)
+	for max < 0 || len(cells) < max {
+		k := len(values)
+		if max >= 0 && max-len(cells) < k {
+			k = max - len(cells)
+		}
+		n, err := cr.Read(values[:k], dLevels[:k], rLevels[:k])
+		if err == EndOfChunk {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, vi := 0, 0; i < n; i++ {
+			c := cell{dLevels[i], rLevels[i], nil}
+			if dLevels[i] == col.MaxD() {
+				c.v = values[vi]
+				vi++
+			}
+			cells = append(cells, c)
+		}
+	}
+	return cells, nil
+}
+
+var skipTestMeta = createFileMetaData(
+	&parquetformat.SchemaElement{
+		Name:        "Test",
+		NumChildren: int32Ptr(2),
+	},
+	&parquetformat.SchemaElement{
+		Name:           "id",
+		Type:           typeInt64,
+		RepetitionType: frtRequired,
+	},
+	&parquetformat.SchemaElement{
+		Name:           "tags",
+		Type:           typeByteArray,
+		RepetitionType: frtRepeated,
+	},
+)
+
+func createSkipTestFile(t *testing.T) *File {
+	var ids, tags []cell
+	for i := 0; i < 100; i++ {
+		ids = append(ids, cell{0, 0, int64(i)})
+		if i%3 == 0 {
+			tags = append(tags, cell{0, 0, nil})
+		}
+		for j := 0; j < i%3; j++ {
+			tags = append(tags, cell{1, uint16(j), []byte(fmt.Sprintf("t%d_%d", i, j))})
+		}
+	}
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, mustCreateSchema(skipTestMeta))
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 7
+	writeTestFile(t, fw, [][][]cell{{ids, tags}})
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	return f
+}
+
+func TestSkipRowsAndSeekToRow(t *testing.T) {
+	for _, withIndex := range []bool{true, false} {
+		f := createSkipTestFile(t)
+		if !withIndex {
+			for _, chunk := range f.MetaData.RowGroups[0].Columns {
+				chunk.OffsetIndexOffset = nil
+				chunk.OffsetIndexLength = nil
+			}
+		}
+
+		for _, col := range f.Schema.Columns() {
+			all, err := readCells(f, col, 0)
+			if err != nil {
+				t.Fatalf("%s: failed to read column: %s", col, err)
+			}
+			rows := splitRows(all)
+
+			cr, err := f.NewReader(col, 0)
+			if err != nil {
+				t.Fatalf("%s: failed to create reader: %s", col, err)
+			}
+			check := func(step string, want []cell) {
+				t.Helper()
+				got, err := readCellsFrom(cr, col, -1)
+				if err != nil {
+					t.Errorf("%s (index = %t), %s: read failed: %s", col, withIndex, step, err)
+				} else if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s (index = %t), %s: got %v, want %v", col, withIndex, step, got, want)
+				}
+			}
+
+			if err = cr.SkipRows(10); err != nil {
+				t.Fatalf("%s: SkipRows(10) failed: %s", col, err)
+			}
+			check("SkipRows(10)", flattenRows(rows[10:]))
+
+			for _, row := range []int64{50, 20, 99, 0, 7, 8} {
+				if err = cr.SeekToRow(row); err != nil {
+					t.Fatalf("%s: SeekToRow(%d) failed: %s", col, row, err)
+				}
+				check(fmt.Sprintf("SeekToRow(%d)", row), flattenRows(rows[row:]))
+			}
+
+			// skip the rest of a partially read row
+			if err = cr.SeekToRow(4); err != nil {
+				t.Fatalf("%s: SeekToRow(4) failed: %s", col, err)
+			}
+			if _, err = readCellsFrom(cr, col, len(rows[4])+1); err != nil {
+				t.Fatalf("%s: read failed: %s", col, err)
+			}
+			if err = cr.SkipRows(0); err != nil {
+				t.Fatalf("%s: SkipRows(0) failed: %s", col, err)
+			}
+			check("SkipRows(0)", flattenRows(rows[6:]))
+
+			if err = cr.SeekToRow(10); err != nil {
+				t.Fatalf("%s: SeekToRow(10) failed: %s", col, err)
+			}
+			if err = cr.SkipRows(90); err != nil {
+				t.Fatalf("%s: SkipRows(90) failed: %s", col, err)
+			}
+			check("SkipRows(90)", nil)
+			if err = cr.SkipRows(1); err != EndOfChunk {
+				t.Errorf("%s: SkipRows(1) at the end: got %v, want EndOfChunk", col, err)
+			}
+			if err = cr.SeekToRow(100); err == nil {
+				t.Errorf("%s: error expected for a non-existent row", col)
+			}
+		}
+	}
+}
+
+func TestSkipRowsTestFiles(t *testing.T) {
+	testFiles := []string{
+		"Booleans",
+		"ByteArrays",
+		"ByteArrays_V2",
+		"ByteArrays_V2_GZIP",
+	}
+	for _, fn := range testFiles {
+		f, err := OpenFile("testdata/" + fn + ".parquet")
+		if err != nil {
+			t.Errorf("failed to open %s: %s", fn, err)
+			continue
+		}
+		for _, col := range f.Schema.Columns() {
+			all, err := readCells(f, col, 0)
+			if err != nil {
+				t.Errorf("%s: failed to read column %s: %s", fn, col, err)
+				continue
+			}
+			rows := splitRows(all)
+			for _, n := range []int{0, 1, 2, 5, len(rows) - 1, len(rows)} {
+				if n < 0 || n > len(rows) {
+					continue
+				}
+				cr, err := f.NewReader(col, 0)
+				if err != nil {
+					t.Errorf("%s: failed to create reader for %s: %s", fn, col, err)
+					break
+				}
+				if err = cr.SkipRows(int64(n)); err != nil {
+					t.Errorf("%s: column %s: SkipRows(%d) failed: %s", fn, col, n, err)
+					continue
+				}
+				got, err := readCellsFrom(cr, col, -1)
+				if err != nil {
+					t.Errorf("%s: column %s: read after SkipRows(%d) failed: %s", fn, col, n, err)
+					continue
+				}
+				if want := flattenRows(rows[n:]); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: column %s: after SkipRows(%d) got %v, want %v", fn, col, n, got, want)
+				}
+			}
+		}
+		f.Close()
+	}
+}
+
+func TestBitPackedDecoder(t *testing.T) {
+	d := newBitPackedDecoder(3)
+	d.init([]byte{0x05, 0x39, 0x77})
+	levels := make([]uint16, 8)
+	if err := d.decodeLevels(levels); err != nil {
+		t.Fatalf("failed to decode levels: %s", err)
+	}
+	if want := []uint16{0, 1, 2, 3, 4, 5, 6, 7}; !reflect.DeepEqual(levels, want) {
+		t.Errorf("got %v, want %v", levels, want)
+	}
+	if err := d.decodeLevels(levels[:1]); err != errNED {
+		t.Errorf("errNED expected, got %v", err)
+	}
+}
+
+// createBitPackedLevelsFile writes a file with a single v1 page per column
+// with levels encoded with BIT_PACKED encoding.
+func createBitPackedLevelsFile(t *testing.T) []byte {
+	meta := createFileMetaData(
+		&parquetformat.SchemaElement{
+			Name:        "Test",
+			NumChildren: int32Ptr(1),
+		},
+		&parquetformat.SchemaElement{
+			Name:           "r",
+			Type:           typeInt32,
+			RepetitionType: frtRepeated,
+		},
+	)
+	// rows: [1, 2], [], [3]
+	// r levels: 0 1 0 0 -> 0100 0000
+	// d levels: 1 1 0 1 -> 1101 0000
+	data := []byte{0x40, 0xD0}
+	for _, v := range []uint32{1, 2, 3} {
+		data = append(data, byte(v), 0, 0, 0)
+	}
+	ph := &parquetformat.PageHeader{
+		Type:                 parquetformat.PageType_DATA_PAGE,
+		UncompressedPageSize: int32(len(data)),
+		CompressedPageSize:   int32(len(data)),
+		DataPageHeader: &parquetformat.DataPageHeader{
+			NumValues:               4,
+			Encoding:                parquetformat.Encoding_PLAIN,
+			DefinitionLevelEncoding: parquetformat.Encoding_BIT_PACKED,
+			RepetitionLevelEncoding: parquetformat.Encoding_BIT_PACKED,
+		},
+	}
+
+	buf := bytes.NewBuffer(append([]byte{}, magic...))
+	offset := int64(buf.Len())
+	if err := ph.Write(buf); err != nil {
+		t.Fatalf("failed to write page header: %s", err)
+	}
+	buf.Write(data)
+	size := int64(buf.Len()) - offset
+	meta.NumRows = 3
+	meta.RowGroups = []*parquetformat.RowGroup{{
+		Columns: []*parquetformat.ColumnChunk{{
+			FileOffset: offset,
+			MetaData: &parquetformat.ColumnMetaData{
+				Type:                  parquetformat.Type_INT32,
+				Encodings:             []parquetformat.Encoding{parquetformat.Encoding_PLAIN, parquetformat.Encoding_BIT_PACKED},
+				PathInSchema:          []string{"r"},
+				Codec:                 parquetformat.CompressionCodec_UNCOMPRESSED,
+				NumValues:             4,
+				TotalUncompressedSize: size,
+				TotalCompressedSize:   size,
+				DataPageOffset:        offset,
+			},
+		}},
+		TotalByteSize: size,
+		NumRows:       3,
+	}}
+	if err := WriteFileMetaData(buf, meta); err != nil {
+		t.Fatalf("failed to write metadata: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadBitPackedLevels(t *testing.T) {
+	f, err := FileFromReader(bytes.NewReader(createBitPackedLevelsFile(t)))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	cells, err := readCells(f, f.Schema.Columns()[0], 0)
+	if err != nil {
+		t.Fatalf("failed to read column: %s", err)
+	}
+	want := []cell{
+		{1, 0, int32(1)},
+		{1, 1, int32(2)},
+		{0, 0, nil},
+		{1, 0, int32(3)},
+	}
+	if !reflect.DeepEqual(cells, want) {
+		t.Errorf("got %v, want %v", cells, want)
+	}
+}
@@ -172,3 +172,145 @@ func (d *rleDecoder) readRunHeader() error {
 	}
 	return nil
 }
+
+// rleEncoder encodes values using RLE/Bit-Packing Hybrid encoding.
+//
+// Values are buffered in groups of 8. A group is bit-packed unless it is a
+// part of a run of at least 8 repeated values, such runs are RLE encoded.
+// Consecutive bit-packed groups are written as a single bit-packed run (of at
+// most 63 groups so that the run header is always 1 byte long).
+type rleEncoder struct {
+	bitWidth     int
+	rleValueSize int
+	bpPacker     pack8int32Func
+
+	data []byte
+
+	// values of the current group of 8 values that have not been written yet
+	buffered    [8]int32
+	numBuffered int
+
+	// current run of repeated values
+	value int32
+	count int
+
+	// current bit-packed run (bpHeaderPos is -1 if there is no bit-packed
+	// run)
+	bpHeaderPos int
+	bpGroups    int
+}
+
+const maxBitPackedRunGroups = 63
+
+// newRLEEncoder creates a new RLE encoder with bit-width w
+func newRLEEncoder(w int) *rleEncoder {
+	if w <= 0 || w > 32 {
+		panic(fmt.Sprintf("invalid bitwidth: %d", w))
+	}
+	return &rleEncoder{
+		bitWidth:     w,
+		rleValueSize: (w + 7) / 8,
+		bpPacker:     pack8Int32FuncByWidth[w],
+		bpHeaderPos:  -1,
+	}
+}
+
+func (e *rleEncoder) encode(v int32) {
+	if e.count > 0 && e.value == v {
+		e.count++
+		if e.count > 8 {
+			// the value is a part of an RLE run
+			return
+		}
+	} else {
+		if e.count >= 8 {
+			e.writeRLERun()
+		}
+		e.value = v
+		e.count = 1
+	}
+	e.buffered[e.numBuffered] = v
+	e.numBuffered++
+	if e.numBuffered == 8 && e.count < 8 {
+		e.writeBitPackedGroup()
+	}
+}
+
+func (e *rleEncoder) encodeLevels(levels []uint16) {
+	for _, l := range levels {
+		e.encode(int32(l))
+	}
+}
+
+// writeRLERun writes the current run of repeated values. Buffered values are
+// all part of this run.
+func (e *rleEncoder) writeRLERun() {
+	e.endBitPackedRun()
+	var buf [binary.MaxVarintLen64 + 4]byte
+	n := binary.PutUvarint(buf[:], uint64(e.count)<<1)
+	for i := 0; i < e.rleValueSize; i++ {
+		buf[n] = byte(e.value >> uint(8*i))
+		n++
+	}
+	e.data = append(e.data, buf[:n]...)
+	e.count = 0
+	e.numBuffered = 0
+}
+
+// writeBitPackedGroup appends buffered values (padded with zeros) to the
+// current bit-packed run.
+func (e *rleEncoder) writeBitPackedGroup() {
+	if e.bpGroups == maxBitPackedRunGroups {
+		e.endBitPackedRun()
+	}
+	if e.bpHeaderPos < 0 {
+		e.bpHeaderPos = len(e.data)
+		e.data = append(e.data, 0)
+	}
+	for i := e.numBuffered; i < 8; i++ {
+		e.buffered[i] = 0
+	}
+	pos := len(e.data)
+	for i := 0; i < e.bitWidth; i++ {
+		e.data = append(e.data, 0)
+	}
+	e.bpPacker(e.data[pos:], e.buffered)
+	e.bpGroups++
+	e.numBuffered = 0
+	// values of the written group cannot start an RLE run
+	e.count = 0
+}
+
+// endBitPackedRun writes the header of the current bit-packed run.
+func (e *rleEncoder) endBitPackedRun() {
+	if e.bpHeaderPos < 0 {
+		return
+	}
+	e.data[e.bpHeaderPos] = byte(e.bpGroups<<1 | 1)
+	e.bpHeaderPos = -1
+	e.bpGroups = 0
+}
+
+// size returns the number of bytes encoded so far (not including the current
+// run and buffered values).
+func (e *rleEncoder) size() int {
+	return len(e.data)
+}
+
+// flush returns all encoded data and resets the encoder. The returned slice is
+// only valid until the next call to encode.
+func (e *rleEncoder) flush() []byte {
+	switch {
+	case e.count >= 8:
+		e.writeRLERun()
+	case e.numBuffered > 0:
+		e.writeBitPackedGroup()
+		e.endBitPackedRun()
+	default:
+		e.endBitPackedRun()
+	}
+	data := e.data
+	e.data = e.data[:0]
+	e.count = 0
+	return data
+}
@@ -16,19 +16,43 @@ widthLoop:
 		d := newRLEDecoder(w)
 		d.init(data)
 
+		var values []int32
 		for i := 0; i < maxCount; i++ {
 			v, err := d.next()
 			if err != nil {
 				if err == errNED {
 					r = 1
+					checkRLERoundTrip(w, values)
 				}
 				continue widthLoop
 			}
 			if bits.LeadingZeros32(uint32(v)) < 32-w {
 				panic(fmt.Sprintf("decoded value %d is too large for width %d", v, w))
 			}
+			values = append(values, v)
 		}
 		r = 1
+		checkRLERoundTrip(w, values)
 	}
 	return r
 }
+
+// checkRLERoundTrip encodes values with rleEncoder and panics if the encoded
+// data is decoded into different values.
+func checkRLERoundTrip(w int, values []int32) {
+	e := newRLEEncoder(w)
+	for _, v := range values {
+		e.encode(v)
+	}
+	d := newRLEDecoder(w)
+	d.init(e.flush())
+	for i, want := range values {
+		v, err := d.next()
+		if err != nil {
+			panic(fmt.Sprintf("failed to decode re-encoded value %d: %s", i, err))
+		}
+		if v != want {
+			panic(fmt.Sprintf("re-encoded value %d is %d, want %d", i, v, want))
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package parquet
 
 import (
+	"bytes"
 	"math"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -138,3 +140,100 @@ func TestDecodeRLEValue(t *testing.T) {
 		}
 	}
 }
+
+func rleEncodeAll(w int, values []int32) []byte {
+	e := newRLEEncoder(w)
+	for _, v := range values {
+		e.encode(v)
+	}
+	return append([]byte{}, e.flush()...)
+}
+
+func TestRLEEncoder(t *testing.T) {
+	var tests = []struct {
+		width  int
+		values []int32
+		data   []byte
+	}{
+		// Single RLE run: 1-bit per value, 10 x 0
+		{1, repeatInt32(10, 0), []byte{0x14, 0x00}},
+
+		// Single RLE run: 20-bits per value, 300x1
+		{20, repeatInt32(300, 1), []byte{0xD8, 0x04, 0x01, 0x00, 0x00}},
+
+		// 1 bit-packed run: 3 bits per value, 0,1,2,3,4,5,6,7
+		{3, []int32{0, 1, 2, 3, 4, 5, 6, 7}, []byte{0x03, 0x88, 0xC6, 0xFA}},
+
+		// RLE run, bit packed run, RLE run: 2 bits per 8x1, 0, 1, 2, 3, 1, 2, 1, 0, 10x2
+		{
+			2,
+			[]int32{
+				1, 1, 1, 1, 1, 1, 1, 1,
+				0, 1, 2, 3, 1, 2, 1, 0,
+				2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+			},
+			[]byte{0x10, 0x01, 0x03, 0xE4, 0x19, 0x14, 0x02},
+		},
+
+		// less than 8 repeated values are bit-packed (padded with 0)
+		{1, repeatInt32(7, 1), []byte{0x03, 0x7F}},
+
+		// 2 bit-packed groups in a single run
+		{1, []int32{1, 0, 1, 0, 1, 0, 1, 0, 0, 1}, []byte{0x05, 0x55, 0x02}},
+
+		// a run of repeated values that doesn't start at a group boundary
+		{
+			2,
+			append([]int32{1, 2, 3}, repeatInt32(13, 0)...),
+			[]byte{0x03, 0x39, 0x00, 0x10, 0x00},
+		},
+
+		// no values
+		{3, nil, nil},
+	}
+
+	for i, test := range tests {
+		data := rleEncodeAll(test.width, test.values)
+		if !bytes.Equal(data, test.data) {
+			t.Errorf("test %d: got %#v, want %#v", i, data, test.data)
+		}
+	}
+}
+
+func TestRLEEncoderRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for w := 1; w <= 32; w++ {
+		max := int64(1) << uint(w)
+		var values []int32
+		for len(values) < 10000 {
+			v := int32(r.Int63n(max))
+			switch r.Intn(4) {
+			case 0:
+				values = append(values, repeatInt32(r.Intn(100), v)...)
+			case 1:
+				values = append(values, repeatInt32(r.Intn(10), v)...)
+			default:
+				values = append(values, v)
+			}
+		}
+
+		e := newRLEEncoder(w)
+		for _, v := range values {
+			e.encode(v)
+		}
+		data := e.flush()
+		got, err := rleDecodeAll(w, data, len(values))
+		if err != nil {
+			t.Fatalf("width %d: failed to decode: %s", w, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Fatalf("width %d: decoded values are different", w)
+		}
+
+		// the encoder can be reused after flush
+		e.encode(1)
+		if got, err := rleDecodeAll(w, e.flush(), 1); err != nil || got[0] != 1 {
+			t.Fatalf("width %d: got %v (err = %v) after flush, want [1]", w, got, err)
+		}
+	}
+}
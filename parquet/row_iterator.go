@@ -0,0 +1,236 @@
+package parquet
+
+import (
+	"fmt"
+)
+
+// RowIterator iterates over rows of a parquet file reading values of the
+// selected columns across all row groups. Only columns without repeated fields
+// in their paths (i.e. with the maximum repetition level 0) can be read by a
+// RowIterator.
+//
+// Typical usage:
+//
+//	it, err := parquet.NewRowIterator(f, "a", "b.c")
+//	if err != nil {
+//		return err
+//	}
+//	for it.Next() {
+//		if !it.IsNull(0) {
+//			fmt.Println(it.Int64(0))
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type RowIterator struct {
+	f    *File
+	cols []*rowIteratorColumn
+	rg   int
+	open bool
+	err  error
+}
+
+// NewRowIterator creates a RowIterator for reading the given columns (names of
+// individual elements are separated with "."). All columns of the schema are
+// read if no columns are specified.
+func NewRowIterator(f *File, columns ...string) (*RowIterator, error) {
+	cols := f.Schema.Columns()
+	if len(columns) > 0 {
+		cols = make([]Column, len(columns))
+		for i, name := range columns {
+			col, found := f.Schema.ColumnByName(name)
+			if !found {
+				return nil, fmt.Errorf("parquet: column %s not found", name)
+			}
+			cols[i] = col
+		}
+	}
+	return NewRowIteratorColumns(f, cols)
+}
+
+// NewRowIteratorColumns creates a RowIterator for reading cols.
+func NewRowIteratorColumns(f *File, cols []Column) (*RowIterator, error) {
+	it := &RowIterator{f: f}
+	schemaCols := f.Schema.Columns()
+	for _, col := range cols {
+		if i := col.Index(); i >= len(schemaCols) || schemaCols[i] != col {
+			return nil, fmt.Errorf("parquet: column %s does not belong to the schema", col)
+		}
+		if col.MaxR() != 0 {
+			return nil, fmt.Errorf("parquet: column %s has repeated elements", col)
+		}
+		c := &rowIteratorColumn{cur: -1}
+		c.init(col, newTypedValues(col, rowReaderBatchSize))
+		it.cols = append(it.cols, c)
+	}
+	return it, nil
+}
+
+// Next advances the iterator to the next row. It returns false when there are
+// no more rows or an error occurred.
+func (it *RowIterator) Next() bool {
+	if it.err != nil || len(it.cols) == 0 {
+		return false
+	}
+	for {
+		if !it.open {
+			if it.rg == len(it.f.MetaData.RowGroups) {
+				return false
+			}
+			for _, c := range it.cols {
+				cr, err := it.f.NewReader(c.col, it.rg)
+				if err != nil {
+					it.err = err
+					return false
+				}
+				c.reset(cr)
+			}
+			it.open = true
+		}
+
+		found := false
+		for i, c := range it.cols {
+			ok, err := c.next()
+			if err != nil {
+				it.err = err
+				return false
+			}
+			if i > 0 && ok != found {
+				it.err = fmt.Errorf("parquet: columns %s and %s have different number of rows in row group %d",
+					it.cols[0].col, c.col, it.rg)
+				return false
+			}
+			found = ok
+		}
+		if found {
+			return true
+		}
+		it.open = false
+		it.rg++
+	}
+}
+
+// Err returns the first error that occurred during iteration.
+func (it *RowIterator) Err() error {
+	return it.err
+}
+
+// Columns returns the columns read by it. Values of the current row are
+// accessed by the index of a column in the returned slice.
+func (it *RowIterator) Columns() []Column {
+	cols := make([]Column, len(it.cols))
+	for i, c := range it.cols {
+		cols[i] = c.col
+	}
+	return cols
+}
+
+// IsNull returns true if the value of the i-th column in the current row is
+// null.
+func (it *RowIterator) IsNull(i int) bool {
+	return it.cols[i].cur < 0
+}
+
+// Value returns the value of the i-th column in the current row or nil if the
+// value is null. The type of the returned value is the same as the type of
+// the values returned by ColumnChunkReader.Read into []interface{}.
+func (it *RowIterator) Value(i int) interface{} {
+	c := it.cols[i]
+	if c.cur < 0 {
+		return nil
+	}
+	switch values := c.values.(type) {
+	case []bool:
+		return values[c.cur]
+	case []int32:
+		return values[c.cur]
+	case []int64:
+		return values[c.cur]
+	case []Int96:
+		return values[c.cur]
+	case []float32:
+		return values[c.cur]
+	case []float64:
+		return values[c.cur]
+	case [][]byte:
+		return values[c.cur]
+	}
+	panic("unexpected values type")
+}
+
+// Bool returns the value of the i-th BOOLEAN column in the current row. The
+// result is undefined if the value is null. Bool panics if the column has a
+// different type. The same applies to other typed accessors.
+func (it *RowIterator) Bool(i int) bool {
+	c := it.cols[i]
+	return c.values.([]bool)[c.index()]
+}
+
+// Int32 returns the value of the i-th INT32 column in the current row.
+func (it *RowIterator) Int32(i int) int32 {
+	c := it.cols[i]
+	return c.values.([]int32)[c.index()]
+}
+
+// Int64 returns the value of the i-th INT64 column in the current row.
+func (it *RowIterator) Int64(i int) int64 {
+	c := it.cols[i]
+	return c.values.([]int64)[c.index()]
+}
+
+// Int96 returns the value of the i-th INT96 column in the current row.
+func (it *RowIterator) Int96(i int) Int96 {
+	c := it.cols[i]
+	return c.values.([]Int96)[c.index()]
+}
+
+// Float32 returns the value of the i-th FLOAT column in the current row.
+func (it *RowIterator) Float32(i int) float32 {
+	c := it.cols[i]
+	return c.values.([]float32)[c.index()]
+}
+
+// Float64 returns the value of the i-th DOUBLE column in the current row.
+func (it *RowIterator) Float64(i int) float64 {
+	c := it.cols[i]
+	return c.values.([]float64)[c.index()]
+}
+
+// ByteArray returns the value of the i-th BYTE_ARRAY or FIXED_LEN_BYTE_ARRAY
+// column in the current row. The returned slice must not be modified.
+func (it *RowIterator) ByteArray(i int) []byte {
+	c := it.cols[i]
+	return c.values.([][]byte)[c.index()]
+}
+
+// rowIteratorColumn reads values of a single column row by row
+type rowIteratorColumn struct {
+	recordReader
+	cur int // index of the current value in values or -1 for null
+}
+
+func (c *rowIteratorColumn) next() (bool, error) {
+	c.cur = -1
+	if err := c.fill(); err != nil {
+		return false, err
+	}
+	if c.eof {
+		return false, nil
+	}
+	if c.dLevels[c.i] == c.col.MaxD() {
+		c.cur = c.vi
+		c.vi++
+	}
+	c.i++
+	return true, nil
+}
+
+// index returns the index of the current value. For null values it returns 0
+// (values is never empty) so that typed accessors do not panic.
+func (c *rowIteratorColumn) index() int {
+	if c.cur < 0 {
+		return 0
+	}
+	return c.cur
+}
@@ -0,0 +1,61 @@
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRowIterator(t *testing.T) {
+	f := createTestFile(t, writerTestMeta, writerTestData)
+
+	it, err := NewRowIterator(f, "i32", "i64", "g.f", "g.s", "r.i96", "empty")
+	if err != nil {
+		t.Fatalf("failed to create row iterator: %s", err)
+	}
+
+	want := [][]interface{}{
+		{int32(1), int64(10), float32(1.5), []byte("abc"), Int96{1}, nil},
+		{int32(2), nil, nil, nil, Int96{2}, nil},
+		{int32(-3), int64(-30), float32(3.5), nil, Int96{3}, nil},
+		{int32(4), int64(40), float32(4.5), []byte(""), Int96{4}, nil},
+	}
+	var got [][]interface{}
+	for it.Next() {
+		row := make([]interface{}, len(it.Columns()))
+		for i := range row {
+			row[i] = it.Value(i)
+			if it.IsNull(i) != (row[i] == nil) {
+				t.Errorf("row %d: IsNull(%d) = %t for %v", len(got), i, it.IsNull(i), row[i])
+			}
+		}
+		if v := it.Int32(0); v != row[0] {
+			t.Errorf("row %d: Int32(0) = %d, want %v", len(got), v, row[0])
+		}
+		if !it.IsNull(3) {
+			if v := it.ByteArray(3); !reflect.DeepEqual(v, row[3]) {
+				t.Errorf("row %d: ByteArray(3) = %v, want %v", len(got), v, row[3])
+			}
+		}
+		got = append(got, row)
+	}
+	if err = it.Err(); err != nil {
+		t.Fatalf("iteration failed: %s", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err = NewRowIterator(f, "b"); err == nil {
+		t.Errorf("error expected for a repeated column")
+	}
+	if _, err = NewRowIterator(f, "x"); err == nil {
+		t.Errorf("error expected for a non-existent column")
+	}
+	other := mustCreateSchema(writerTestMeta).Columns()[0]
+	if _, err = NewRowIteratorColumns(f, []Column{other}); err == nil {
+		t.Errorf("error expected for a column of another schema")
+	}
+	if _, err = NewRowIteratorColumns(f, []Column{{}}); err == nil {
+		t.Errorf("error expected for a zero column")
+	}
+}
@@ -0,0 +1,282 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// number of levels read from a column chunk at a time by RowReader
+const rowReaderBatchSize = 256
+
+// RowReader reads whole records from a single row group of a parquet file.
+// Data of all selected columns is read in lock-step and assembled into nested
+// structures that follow the group structure of the schema:
+//
+//   - a group (including the root of the schema) is represented as
+//     map[string]interface{} with an entry for every child field
+//   - a repeated field is represented as []interface{}, an empty slice is used
+//     when there are no elements
+//   - a null optional field (either primitive or group) is represented as nil
+//   - a primitive value has the same type as the values returned by
+//     ColumnChunkReader.Read into []interface{}
+//
+// LIST and MAP annotations are not interpreted, such groups are returned as is.
+type RowReader struct {
+	cols []*rowColumn
+	err  error
+}
+
+// NewRowReader creates a RowReader for reading records from row group rg.
+// Only fields of the specified columns are read. All columns of the schema
+// are read if cols is empty.
+func (f *File) NewRowReader(rg int, cols ...Column) (*RowReader, error) {
+	if len(cols) == 0 {
+		cols = f.Schema.Columns()
+	}
+	schemaCols := f.Schema.Columns()
+	rr := &RowReader{}
+	for _, col := range cols {
+		if i := col.Index(); i >= len(schemaCols) || schemaCols[i] != col {
+			return nil, fmt.Errorf("parquet: column %s does not belong to the schema", col)
+		}
+		elems, err := f.Schema.pathElements(col)
+		if err != nil {
+			return nil, err
+		}
+		cr, err := f.NewReader(col, rg)
+		if err != nil {
+			return nil, err
+		}
+		rr.cols = append(rr.cols, newRowColumn(col, elems, cr))
+	}
+	return rr, nil
+}
+
+// Next reads the next record. io.EOF is returned when all records from the row
+// group have been read.
+func (rr *RowReader) Next() (map[string]interface{}, error) {
+	if rr.err != nil {
+		return nil, rr.err
+	}
+	rec := make(map[string]interface{})
+	found := false
+	for i, rc := range rr.cols {
+		ok, err := rc.readRecord(rec)
+		if err != nil {
+			rr.err = err
+			return nil, err
+		}
+		if i > 0 && ok != found {
+			rr.err = fmt.Errorf("parquet: columns %s and %s have different number of rows",
+				rr.cols[0].col, rc.col)
+			return nil, rr.err
+		}
+		found = ok
+	}
+	if !found {
+		rr.err = io.EOF
+		return nil, rr.err
+	}
+	return rec, nil
+}
+
+// pathNode describes a schema element on the path to a column
+type pathNode struct {
+	name     string
+	d        uint16 // the definition level when the node is defined
+	r        uint16 // repetition level of the node (if repeated)
+	repeated bool
+	leaf     bool
+}
+
+// rowColumn adds values of a single column to records
+type rowColumn struct {
+	recordReader
+	nodes []pathNode
+}
+
+func newRowColumn(col Column, elems []*parquetformat.SchemaElement, cr *ColumnChunkReader) *rowColumn {
+	rc := &rowColumn{}
+	rc.init(col, make([]interface{}, rowReaderBatchSize))
+	rc.cr = cr
+	var d, r uint16
+	for i, s := range elems {
+		node := pathNode{name: s.Name, leaf: i == len(elems)-1}
+		switch *s.RepetitionType {
+		case parquetformat.FieldRepetitionType_OPTIONAL:
+			d++
+		case parquetformat.FieldRepetitionType_REPEATED:
+			d++
+			r++
+			node.repeated = true
+		}
+		node.d = d
+		node.r = r
+		rc.nodes = append(rc.nodes, node)
+	}
+	return rc
+}
+
+// readRecord adds all values of the next record to rec. It returns false if
+// there are no more records.
+func (rc *rowColumn) readRecord(rec map[string]interface{}) (bool, error) {
+	values := rc.values.([]interface{})
+	return rc.recordReader.readRecord(func(d uint16, r uint16, vi int) error {
+		var v interface{}
+		if vi >= 0 {
+			v = values[vi]
+		}
+		return rc.add(rec, d, r, v)
+	})
+}
+
+// add adds a single value with definition level d and repetition level r to
+// rec.
+func (rc *rowColumn) add(rec map[string]interface{}, d uint16, r uint16, v interface{}) error {
+	m := rec
+	for _, node := range rc.nodes {
+		if d < node.d {
+			// the node is not defined: null or an empty list
+			if _, ok := m[node.name]; !ok {
+				if node.repeated {
+					m[node.name] = []interface{}{}
+				} else {
+					m[node.name] = nil
+				}
+			}
+			return nil
+		}
+
+		if node.repeated {
+			list, _ := m[node.name].([]interface{})
+			i := rc.idx[node.r-1]
+			switch {
+			case i == len(list):
+				var elem interface{} = v
+				if !node.leaf {
+					elem = make(map[string]interface{})
+				}
+				list = append(list, elem)
+				m[node.name] = list
+			case i > len(list) || node.leaf:
+				return fmt.Errorf("parquet: invalid repetition level %d in column %s", r, rc.col)
+			}
+			if node.leaf {
+				return nil
+			}
+			m = list[i].(map[string]interface{})
+			continue
+		}
+
+		if node.leaf {
+			m[node.name] = v
+			return nil
+		}
+		child, ok := m[node.name].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			m[node.name] = child
+		}
+		m = child
+	}
+	return nil
+}
+
+// recordReader reads levels and values of a single column chunk record by
+// record.
+type recordReader struct {
+	col Column
+	cr  *ColumnChunkReader
+
+	values  interface{}
+	dLevels []uint16
+	rLevels []uint16
+	n       int
+	i       int
+	vi      int
+	eof     bool
+
+	// indexes of current elements of all repeated fields on the path,
+	// idx[k] is for a field with repetition level k+1
+	idx []int
+}
+
+// init initializes rr to read col using values as a buffer for read values.
+func (rr *recordReader) init(col Column, values interface{}) {
+	n := reflect.ValueOf(values).Len()
+	rr.col = col
+	rr.values = values
+	rr.dLevels = make([]uint16, n)
+	rr.rLevels = make([]uint16, n)
+	rr.idx = make([]int, col.MaxR())
+}
+
+// reset starts reading a new column chunk using cr.
+func (rr *recordReader) reset(cr *ColumnChunkReader) {
+	rr.cr = cr
+	rr.n, rr.i, rr.vi = 0, 0, 0
+	rr.eof = false
+}
+
+// fill reads the next batch of levels and values if all previously read data
+// has been consumed.
+func (rr *recordReader) fill() error {
+	for rr.i >= rr.n && !rr.eof {
+		n, err := rr.cr.Read(rr.values, rr.dLevels, rr.rLevels)
+		if err == EndOfChunk {
+			rr.eof = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("parquet: failed to read column %s: %s", rr.col, err)
+		}
+		rr.n, rr.i, rr.vi = n, 0, 0
+	}
+	return nil
+}
+
+// readRecord calls add for every value of the next record (vi is the index of
+// the value in rr.values or -1 for null values). Before add is called rr.idx
+// is updated to point to the current elements of the repeated fields. It
+// returns false if there are no more records.
+func (rr *recordReader) readRecord(add func(d uint16, r uint16, vi int) error) (bool, error) {
+	if err := rr.fill(); err != nil {
+		return false, err
+	}
+	if rr.eof {
+		return false, nil
+	}
+	for {
+		d, r := rr.dLevels[rr.i], rr.rLevels[rr.i]
+		vi := -1
+		if d == rr.col.MaxD() {
+			vi = rr.vi
+			rr.vi++
+		}
+		rr.i++
+
+		// repetition level r starts a new element of the repeated field with
+		// this level, all repeated fields below it start from the first
+		// element
+		if r > 0 {
+			rr.idx[r-1]++
+		}
+		for k := int(r); k < len(rr.idx); k++ {
+			rr.idx[k] = 0
+		}
+
+		if err := add(d, r, vi); err != nil {
+			return false, err
+		}
+
+		if err := rr.fill(); err != nil {
+			return false, err
+		}
+		if rr.eof || rr.rLevels[rr.i] == 0 {
+			return true, nil
+		}
+	}
+}
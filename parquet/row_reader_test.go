@@ -0,0 +1,206 @@
+package parquet
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// createTestFile writes data to an in-memory parquet file with the given
+// schema and opens it for reading.
+func createTestFile(t *testing.T, meta *pf.FileMetaData, data [][][]cell) *File {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, mustCreateSchema(meta))
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	writeTestFile(t, fw, data)
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	return f
+}
+
+func readRows(t *testing.T, f *File, rg int, cols ...Column) []map[string]interface{} {
+	t.Helper()
+	rr, err := f.NewRowReader(rg, cols...)
+	if err != nil {
+		t.Fatalf("failed to create row reader: %s", err)
+	}
+	var rows []map[string]interface{}
+	for {
+		row, err := rr.Next()
+		if err == io.EOF {
+			return rows
+		}
+		if err != nil {
+			t.Fatalf("failed to read row %d: %s", len(rows), err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func TestRowReader(t *testing.T) {
+	f := createTestFile(t, writerTestMeta, writerTestData)
+
+	type m = map[string]interface{}
+	type l = []interface{}
+	want := [][]map[string]interface{}{
+		{
+			{
+				"i32":   int32(1),
+				"i64":   int64(10),
+				"b":     l{true, false},
+				"g":     m{"f": float32(1.5), "d": l{float64(1), float64(2)}, "s": []byte("abc")},
+				"r":     m{"i96": Int96{1}},
+				"fixed": []byte("xyz"),
+				"empty": nil,
+			},
+			{
+				"i32":   int32(2),
+				"i64":   nil,
+				"b":     l{},
+				"g":     nil,
+				"r":     m{"i96": Int96{2}},
+				"fixed": []byte("123"),
+				"empty": nil,
+			},
+			{
+				"i32":   int32(-3),
+				"i64":   int64(-30),
+				"b":     l{true},
+				"g":     m{"f": float32(3.5), "d": l{}, "s": nil},
+				"r":     m{"i96": Int96{3}},
+				"fixed": nil,
+				"empty": nil,
+			},
+		},
+		{
+			{
+				"i32":   int32(4),
+				"i64":   int64(40),
+				"b":     l{false, false, true},
+				"g":     m{"f": float32(4.5), "d": l{}, "s": []byte("")},
+				"r":     m{"i96": Int96{4}},
+				"fixed": nil,
+				"empty": nil,
+			},
+		},
+	}
+
+	for rg := range want {
+		if got := readRows(t, f, rg); !reflect.DeepEqual(got, want[rg]) {
+			t.Errorf("row group %d: got\n%v\nwant\n%v", rg, got, want[rg])
+		}
+	}
+
+	cols := f.Schema.Columns()
+	got := readRows(t, f, 1, cols[2], cols[4])
+	wantSelected := []map[string]interface{}{
+		{"b": l{false, false, true}, "g": m{"d": l{}}},
+	}
+	if !reflect.DeepEqual(got, wantSelected) {
+		t.Errorf("selected columns: got %v, want %v", got, wantSelected)
+	}
+}
+
+// dremelPaperExampleData contains r1 record from the Dremel paper and a record
+// without names
+var dremelPaperExampleData = [][][]cell{{
+	// DocId
+	{{0, 0, int64(10)}, {0, 0, int64(20)}},
+	// Links.Backward
+	{{1, 0, nil}, {2, 0, int64(10)}, {2, 1, int64(30)}},
+	// Links.Forward
+	{{2, 0, int64(20)}, {2, 1, int64(40)}, {2, 1, int64(60)}, {2, 0, int64(80)}},
+	// Name.Language.Code
+	{
+		{2, 0, []byte("en-us")}, {2, 2, []byte("en")}, {1, 1, nil}, {2, 1, []byte("en-gb")},
+		{0, 0, nil},
+	},
+	// Name.Language.Country
+	{
+		{3, 0, []byte("us")}, {2, 2, nil}, {1, 1, nil}, {3, 1, []byte("gb")},
+		{0, 0, nil},
+	},
+	// Name.Url
+	{
+		{2, 0, []byte("http://A")}, {2, 1, []byte("http://B")}, {1, 1, nil},
+		{0, 0, nil},
+	},
+}}
+
+func TestRowReaderNested(t *testing.T) {
+	f := createTestFile(t, dremelPaperExampleMeta, dremelPaperExampleData)
+
+	type m = map[string]interface{}
+	type l = []interface{}
+	want := []map[string]interface{}{
+		{
+			"DocId": int64(10),
+			"Links": m{"Backward": l{}, "Forward": l{int64(20), int64(40), int64(60)}},
+			"Name": l{
+				m{
+					"Language": l{
+						m{"Code": []byte("en-us"), "Country": []byte("us")},
+						m{"Code": []byte("en"), "Country": nil},
+					},
+					"Url": []byte("http://A"),
+				},
+				m{
+					"Language": l{},
+					"Url":      []byte("http://B"),
+				},
+				m{
+					"Language": l{
+						m{"Code": []byte("en-gb"), "Country": []byte("gb")},
+					},
+					"Url": nil,
+				},
+			},
+		},
+		{
+			"DocId": int64(20),
+			"Links": m{"Backward": l{int64(10), int64(30)}, "Forward": l{int64(80)}},
+			"Name":  l{},
+		},
+	}
+	if got := readRows(t, f, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestRowReaderDottedNames(t *testing.T) {
+	meta := createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(2)},
+		&pf.SchemaElement{Name: "a", RepetitionType: frtOptional, NumChildren: int32Ptr(1)},
+		&pf.SchemaElement{Name: "b", Type: typeInt32, RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "a.b", Type: typeInt64, RepetitionType: frtRequired},
+	)
+	data := [][][]cell{{
+		{{1, 0, int32(1)}, {0, 0, nil}},
+		{{0, 0, int64(2)}, {0, 0, int64(3)}},
+	}}
+	f := createTestFile(t, meta, data)
+
+	if col, found := f.Schema.ColumnByPath([]string{"a.b"}); !found || col.Index() != 1 {
+		t.Errorf("ColumnByPath([a.b]) = %v, %t, want column 1", col.Index(), found)
+	}
+	if col, found := f.Schema.ColumnByPath([]string{"a", "b"}); !found || col.Index() != 0 {
+		t.Errorf("ColumnByPath([a b]) = %v, %t, want column 0", col.Index(), found)
+	}
+
+	type m = map[string]interface{}
+	want := []map[string]interface{}{
+		{"a": m{"b": int32(1)}, "a.b": int64(2)},
+		{"a": nil, "a.b": int64(3)},
+	}
+	if got := readRows(t, f, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%v\nwant\n%v", got, want)
+	}
+}
@@ -19,16 +19,18 @@ import (
 //
 // Usually FileMetaData should be read from the same file as data. When data is
 // split into multiple parquet files metadata can be stored in a separate
-// file. Usually this file is called "_common_metadata".
+// file. Usually this file is called "_common_metadata" (see OpenDataset).
 type Schema struct {
-	root    group
-	columns []Column
+	root     group
+	columns  []Column
+	elements []*parquetformat.SchemaElement
 }
 
 // Column contains information about a single column in a parquet file.
 type Column struct {
 	index         int
 	name          string
+	path          *[]string // a pointer keeps Column comparable
 	maxD          uint16
 	maxR          uint16
 	schemaElement *parquetformat.SchemaElement
@@ -41,6 +43,15 @@ func (col Column) Index() int {
 	return col.index
 }
 
+// Path returns the names of all schema elements from the root to col (the
+// root itself is not included).
+func (col Column) Path() []string {
+	if col.path == nil {
+		return nil
+	}
+	return append([]string(nil), *col.path...)
+}
+
 // MaxD returns the maximum definition level for col.
 //
 // A read value is not null when its definition level equals to the maximum
@@ -79,6 +90,7 @@ func MakeSchema(meta *parquetformat.FileMetaData) (Schema, error) {
 	for i := range s.columns {
 		s.columns[i].index = i
 	}
+	s.elements = meta.Schema
 
 	return s, nil
 }
@@ -96,7 +108,60 @@ func (s Schema) ColumnByName(name string) (col Column, found bool) {
 
 // ColumnByPath returns a Column for the given path.
 func (s Schema) ColumnByPath(path []string) (col Column, found bool) {
-	return s.ColumnByName(strings.Join(path, "."))
+	for i := range s.columns {
+		if equalPaths(*s.columns[i].path, path) {
+			return s.columns[i], true
+		}
+	}
+	return Column{}, false
+}
+
+func equalPaths(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pathElements returns SchemaElements of all schema nodes from the root to
+// col (the root itself is not included).
+func (s Schema) pathElements(col Column) ([]*parquetformat.SchemaElement, error) {
+	var elems []*parquetformat.SchemaElement
+	g := &s.root
+	for _, name := range col.Path() {
+		var next schemaElement
+		for _, child := range g.children {
+			switch c := child.(type) {
+			case *group:
+				if c.schemaElement.Name == name {
+					next = c
+				}
+			case *primitive:
+				if c.schemaElement.Name == name {
+					next = c
+				}
+			}
+			if next != nil {
+				break
+			}
+		}
+		switch c := next.(type) {
+		case *group:
+			elems = append(elems, c.schemaElement)
+			g = c
+		case *primitive:
+			elems = append(elems, c.schemaElement)
+			return elems, nil
+		default:
+			return nil, fmt.Errorf("parquet: column %s does not belong to the schema", col)
+		}
+	}
+	return nil, fmt.Errorf("parquet: column %s does not belong to the schema", col)
 }
 
 // Columns returns all columns defined in s.
@@ -226,7 +291,7 @@ func (g *group) collectColumns() []Column {
 			if *s.RepetitionType == parquetformat.FieldRepetitionType_REPEATED {
 				r = 1
 			}
-			cols = append(cols, Column{name: s.Name, maxD: d, maxR: r, schemaElement: s})
+			cols = append(cols, Column{name: s.Name, path: &[]string{s.Name}, maxD: d, maxR: r, schemaElement: s})
 		case *group:
 			s := c.schemaElement
 			for _, col := range c.collectColumns() {
@@ -237,6 +302,8 @@ func (g *group) collectColumns() []Column {
 					col.maxR++ // TODO: handle overflow
 				}
 				col.name = s.Name + "." + col.name
+				path := append([]string{s.Name}, *col.path...)
+				col.path = &path
 				cols = append(cols, col)
 			}
 		default:
@@ -1,6 +1,7 @@
 package parquet
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
@@ -259,7 +260,10 @@ func TestSchemaColumns(t *testing.T) {
 		if found != found2 || col != col2 {
 			t.Errorf("ColumnByPath(%v) = %+v is not the same as ColumnByName(%s) = %+v", path, col, name, col2)
 		}
-		if (expected == nil && found) || (expected != nil && *expected != col) {
+		if expected != nil {
+			expected.path = &path
+		}
+		if (expected == nil && found) || (expected != nil && !reflect.DeepEqual(*expected, col)) {
 			t.Errorf("wrong ColumnSchema for %v: got %+v, want %+v", path, col, expected)
 		}
 	}
@@ -0,0 +1,710 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// sortOrder is the order of values of a column used for min/max statistics.
+type sortOrder int
+
+const (
+	sortOrderUnknown sortOrder = iota
+	sortOrderSigned
+	sortOrderUnsigned
+)
+
+// columnSortOrder returns the sort order of col values defined by its physical
+// type and logical (or converted) type.
+func columnSortOrder(col Column) sortOrder {
+	if lt := col.schemaElement.LogicalType; lt != nil {
+		switch {
+		case lt.STRING != nil, lt.ENUM != nil, lt.JSON != nil, lt.BSON != nil, lt.UUID != nil:
+			return sortOrderUnsigned
+		case lt.DECIMAL != nil, lt.DATE != nil, lt.TIME != nil, lt.TIMESTAMP != nil:
+			return sortOrderSigned
+		case lt.INTEGER != nil:
+			if lt.INTEGER.IsSigned {
+				return sortOrderSigned
+			}
+			return sortOrderUnsigned
+		}
+	}
+
+	switch convertedType(col) {
+	case parquetformat.ConvertedType_UTF8,
+		parquetformat.ConvertedType_ENUM,
+		parquetformat.ConvertedType_JSON,
+		parquetformat.ConvertedType_BSON,
+		parquetformat.ConvertedType_UINT_8,
+		parquetformat.ConvertedType_UINT_16,
+		parquetformat.ConvertedType_UINT_32,
+		parquetformat.ConvertedType_UINT_64:
+		return sortOrderUnsigned
+	case parquetformat.ConvertedType_INT_8,
+		parquetformat.ConvertedType_INT_16,
+		parquetformat.ConvertedType_INT_32,
+		parquetformat.ConvertedType_INT_64,
+		parquetformat.ConvertedType_DECIMAL,
+		parquetformat.ConvertedType_DATE,
+		parquetformat.ConvertedType_TIME_MILLIS,
+		parquetformat.ConvertedType_TIME_MICROS,
+		parquetformat.ConvertedType_TIMESTAMP_MILLIS,
+		parquetformat.ConvertedType_TIMESTAMP_MICROS:
+		return sortOrderSigned
+	case parquetformat.ConvertedType_INTERVAL:
+		return sortOrderUnknown
+	}
+
+	switch col.Type() {
+	case parquetformat.Type_BOOLEAN,
+		parquetformat.Type_BYTE_ARRAY,
+		parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		return sortOrderUnsigned
+	case parquetformat.Type_INT32,
+		parquetformat.Type_INT64,
+		parquetformat.Type_FLOAT,
+		parquetformat.Type_DOUBLE:
+		return sortOrderSigned
+	}
+	return sortOrderUnknown
+}
+
+// valueComparator returns a function that compares values of type t (as
+// returned by decodeStatValue) using order. The function returns a negative
+// number if a < b, 0 if a == b and a positive number if a > b. nil is returned
+// if values of type t cannot be compared using order.
+func valueComparator(t parquetformat.Type, order sortOrder) func(a, b interface{}) int {
+	if order == sortOrderUnknown {
+		return nil
+	}
+	unsigned := order == sortOrderUnsigned
+	switch t {
+	case parquetformat.Type_BOOLEAN:
+		return compareBool
+	case parquetformat.Type_INT32:
+		if unsigned {
+			return func(a, b interface{}) int {
+				return compareUint64(uint64(uint32(a.(int32))), uint64(uint32(b.(int32))))
+			}
+		}
+		return func(a, b interface{}) int {
+			return compareInt64(int64(a.(int32)), int64(b.(int32)))
+		}
+	case parquetformat.Type_INT64:
+		if unsigned {
+			return func(a, b interface{}) int {
+				return compareUint64(uint64(a.(int64)), uint64(b.(int64)))
+			}
+		}
+		return func(a, b interface{}) int {
+			return compareInt64(a.(int64), b.(int64))
+		}
+	case parquetformat.Type_FLOAT:
+		if !unsigned {
+			return func(a, b interface{}) int {
+				return compareFloat64(floatValue(a), floatValue(b))
+			}
+		}
+	case parquetformat.Type_DOUBLE:
+		if !unsigned {
+			return func(a, b interface{}) int {
+				return compareFloat64(a.(float64), b.(float64))
+			}
+		}
+	case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		if unsigned {
+			return func(a, b interface{}) int {
+				return bytes.Compare(a.([]byte), b.([]byte))
+			}
+		}
+		return func(a, b interface{}) int {
+			return compareSignedBytes(a.([]byte), b.([]byte))
+		}
+	}
+	return nil
+}
+
+func compareBool(a, b interface{}) int {
+	x, y := a.(bool), b.(bool)
+	switch {
+	case x == y:
+		return 0
+	case y:
+		return -1
+	}
+	return 1
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// floatValue returns v (a value of a FLOAT column or a float64 filter value)
+// as float64.
+func floatValue(v interface{}) float64 {
+	if f, ok := v.(float32); ok {
+		return float64(f)
+	}
+	return v.(float64)
+}
+
+// compareFloat64 compares a and b, neither of them can be NaN.
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareSignedBytes compares big-endian two's complement integers (e.g.
+// DECIMAL values stored as BYTE_ARRAY or FIXED_LEN_BYTE_ARRAY).
+func compareSignedBytes(a, b []byte) int {
+	aNeg := len(a) > 0 && a[0]&0x80 != 0
+	bNeg := len(b) > 0 && b[0]&0x80 != 0
+	if aNeg != bNeg {
+		if aNeg {
+			return -1
+		}
+		return 1
+	}
+
+	// sign-extend the shorter number
+	var ext byte
+	if aNeg {
+		ext = 0xff
+	}
+	for len(a) > len(b) {
+		if a[0] != ext {
+			return compareUint64(uint64(a[0]), uint64(ext))
+		}
+		a = a[1:]
+	}
+	for len(b) > len(a) {
+		if b[0] != ext {
+			return compareUint64(uint64(ext), uint64(b[0]))
+		}
+		b = b[1:]
+	}
+	return bytes.Compare(a, b)
+}
+
+// decodeStatValue decodes a PLAIN encoded value of type t stored in min or max
+// fields of parquetformat.Statistics. nil is returned if b is not a valid
+// value or it cannot be used for comparisons (e.g. NaN).
+func decodeStatValue(t parquetformat.Type, b []byte) interface{} {
+	switch t {
+	case parquetformat.Type_BOOLEAN:
+		if len(b) == 1 {
+			return b[0] != 0
+		}
+	case parquetformat.Type_INT32:
+		if len(b) == 4 {
+			return int32(binary.LittleEndian.Uint32(b))
+		}
+	case parquetformat.Type_INT64:
+		if len(b) == 8 {
+			return int64(binary.LittleEndian.Uint64(b))
+		}
+	case parquetformat.Type_INT96:
+		if len(b) == 12 {
+			var v Int96
+			copy(v[:], b)
+			return v
+		}
+	case parquetformat.Type_FLOAT:
+		if len(b) == 4 {
+			v := math.Float32frombits(binary.LittleEndian.Uint32(b))
+			if v == v {
+				return v
+			}
+		}
+	case parquetformat.Type_DOUBLE:
+		if len(b) == 8 {
+			v := math.Float64frombits(binary.LittleEndian.Uint64(b))
+			if v == v {
+				return v
+			}
+		}
+	case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		return b
+	}
+	return nil
+}
+
+// columnChunkStats returns statistics of the column chunk of col in row group
+// rg.
+//
+// min_value and max_value fields are used only when the file defines the type
+// defined order for col. Otherwise the deprecated min and max fields are used
+// if they are compatible with the sort order of col: they were computed using
+// signed comparison and cannot be used for unsigned integers and byte arrays.
+func (f *File) columnChunkStats(col Column, rg int) *valueStats {
+	order := columnSortOrder(col)
+	s := newValueStats(col)
+
+	chunks := f.MetaData.RowGroups[rg].Columns
+	if col.Index() >= len(chunks) || chunks[col.Index()].MetaData == nil {
+		return s
+	}
+	meta := chunks[col.Index()].MetaData
+	s.numValues = meta.NumValues
+	stats := meta.Statistics
+	if stats == nil {
+		return s
+	}
+	if stats.NullCount != nil {
+		s.nullCount = *stats.NullCount
+	}
+
+	min, max := stats.MinValue, stats.MaxValue
+	if min == nil || max == nil || !f.typeDefinedOrder(col) {
+		min, max = nil, nil
+		switch col.Type() {
+		case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		default:
+			if order == sortOrderSigned || col.Type() == parquetformat.Type_BOOLEAN {
+				min, max = stats.Min, stats.Max
+			}
+		}
+	}
+	if min != nil && max != nil {
+		s.min = decodeStatValue(col.Type(), min)
+		s.max = decodeStatValue(col.Type(), max)
+		if s.min == nil || s.max == nil {
+			s.min, s.max = nil, nil
+		}
+	}
+	return s
+}
+
+// pageStats returns statistics of the i-th page of a column chunk of col from
+// its column index ci.
+func pageStats(col Column, ci *parquetformat.ColumnIndex, i int) *valueStats {
+	s := newValueStats(col)
+	if ci.NullPages[i] {
+		s.onlyNulls = true
+		return s
+	}
+	if i < len(ci.NullCounts) {
+		s.nullCount = ci.NullCounts[i]
+	}
+	s.min = decodeStatValue(col.Type(), ci.MinValues[i])
+	s.max = decodeStatValue(col.Type(), ci.MaxValues[i])
+	if s.min == nil || s.max == nil {
+		s.min, s.max = nil, nil
+	}
+	return s
+}
+
+// newValueStats returns valueStats for values of col without any statistics.
+func newValueStats(col Column) *valueStats {
+	order := columnSortOrder(col)
+	return &valueStats{
+		nullCount: -1,
+		numValues: -1,
+		compare:   valueComparator(col.Type(), order),
+		convert: func(v interface{}) (interface{}, error) {
+			return convertFilterValue(col, order, v)
+		},
+	}
+}
+
+// typeDefinedOrder returns true if the file metadata specifies that
+// min_value and max_value statistics of col use the type defined order.
+func (f *File) typeDefinedOrder(col Column) bool {
+	orders := f.MetaData.ColumnOrders
+	return col.Index() < len(orders) && orders[col.Index()] != nil && orders[col.Index()].TYPE_ORDER != nil
+}
+
+// isStringColumn returns true if values of col are UTF-8 strings.
+func isStringColumn(col Column) bool {
+	if lt := col.schemaElement.LogicalType; lt != nil {
+		return lt.STRING != nil || lt.ENUM != nil || lt.JSON != nil
+	}
+	switch convertedType(col) {
+	case parquetformat.ConvertedType_UTF8,
+		parquetformat.ConvertedType_ENUM,
+		parquetformat.ConvertedType_JSON:
+		return true
+	}
+	return false
+}
+
+// statsAccumulator computes statistics of values written to a data page or
+// a column chunk.
+type statsAccumulator struct {
+	typ     parquetformat.Type
+	order   sortOrder
+	compare func(a, b interface{}) int
+	strings bool
+
+	nullCount int64
+	numValues int64       // number of non-null values
+	min, max  interface{} // nil if there are no values that can be compared
+}
+
+func newStatsAccumulator(col Column) *statsAccumulator {
+	order := columnSortOrder(col)
+	return &statsAccumulator{
+		typ:     col.Type(),
+		order:   order,
+		compare: valueComparator(col.Type(), order),
+		strings: isStringColumn(col),
+	}
+}
+
+// add updates statistics with non-null values (a slice of interface{} or of a
+// type that corresponds to the column type) and the number of nulls.
+//
+// NaN values are ignored for min/max statistics.
+func (s *statsAccumulator) add(values interface{}, nulls int) {
+	s.nullCount += int64(nulls)
+	if values == nil {
+		return
+	}
+	n := reflect.ValueOf(values).Len()
+	s.numValues += int64(n)
+	if s.compare == nil || n == 0 {
+		return
+	}
+
+	unsigned := s.order == sortOrderUnsigned
+	switch values := values.(type) {
+	case []bool:
+		min, max := values[0], values[0]
+		for _, v := range values[1:] {
+			min = min && v
+			max = max || v
+		}
+		s.merge(min, max)
+	case []int32:
+		min, max := values[0], values[0]
+		for _, v := range values[1:] {
+			if unsigned {
+				if uint32(v) < uint32(min) {
+					min = v
+				}
+				if uint32(v) > uint32(max) {
+					max = v
+				}
+			} else {
+				if v < min {
+					min = v
+				}
+				if v > max {
+					max = v
+				}
+			}
+		}
+		s.merge(min, max)
+	case []int64:
+		min, max := values[0], values[0]
+		for _, v := range values[1:] {
+			if unsigned {
+				if uint64(v) < uint64(min) {
+					min = v
+				}
+				if uint64(v) > uint64(max) {
+					max = v
+				}
+			} else {
+				if v < min {
+					min = v
+				}
+				if v > max {
+					max = v
+				}
+			}
+		}
+		s.merge(min, max)
+	case []float32:
+		found := false
+		var min, max float32
+		for _, v := range values {
+			switch {
+			case v != v:
+			case !found:
+				min, max, found = v, v, true
+			case v < min:
+				min = v
+			case v > max:
+				max = v
+			}
+		}
+		if found {
+			s.merge(min, max)
+		}
+	case []float64:
+		found := false
+		var min, max float64
+		for _, v := range values {
+			switch {
+			case v != v:
+			case !found:
+				min, max, found = v, v, true
+			case v < min:
+				min = v
+			case v > max:
+				max = v
+			}
+		}
+		if found {
+			s.merge(min, max)
+		}
+	case [][]byte:
+		cmp := bytes.Compare
+		if !unsigned {
+			cmp = compareSignedBytes
+		}
+		min, max := values[0], values[0]
+		for _, v := range values[1:] {
+			if cmp(v, min) < 0 {
+				min = v
+			}
+			if cmp(v, max) > 0 {
+				max = v
+			}
+		}
+		s.merge(min, max)
+	case []interface{}:
+		for _, v := range values {
+			switch f := v.(type) {
+			case float32:
+				if f != f {
+					continue
+				}
+			case float64:
+				if f != f {
+					continue
+				}
+			}
+			s.merge(v, v)
+		}
+	}
+}
+
+// merge updates min and max statistics with the given min and max values.
+func (s *statsAccumulator) merge(min, max interface{}) {
+	if s.min == nil || s.compare(min, s.min) < 0 {
+		s.min = cloneStatValue(min)
+	}
+	if s.max == nil || s.compare(max, s.max) > 0 {
+		s.max = cloneStatValue(max)
+	}
+}
+
+// mergeStats updates statistics with statistics of other values (e.g. of a
+// data page).
+func (s *statsAccumulator) mergeStats(other *statsAccumulator) {
+	s.nullCount += other.nullCount
+	s.numValues += other.numValues
+	if other.min != nil {
+		s.merge(other.min, other.max)
+	}
+}
+
+func (s *statsAccumulator) reset() {
+	s.nullCount = 0
+	s.numValues = 0
+	s.min, s.max = nil, nil
+}
+
+// minMax returns PLAIN encoded min and max values or nils if there are no
+// min/max statistics. Byte arrays compared as unsigned are truncated to at
+// most truncateLength bytes (if truncateLength > 0), the truncated max value
+// is incremented to remain an upper bound.
+func (s *statsAccumulator) minMax(truncateLength int) (min []byte, max []byte) {
+	if s.min == nil {
+		return nil, nil
+	}
+	min = encodeStatValue(s.min, true)
+	max = encodeStatValue(s.max, false)
+	if truncateLength > 0 && s.order == sortOrderUnsigned {
+		switch s.typ {
+		case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+			min = truncateMinValue(min, truncateLength, s.strings)
+			max = truncateMaxValue(max, truncateLength, s.strings)
+		}
+	}
+	return min, max
+}
+
+// statistics returns the statistics in the form stored in data page headers
+// and column chunk metadata. Deprecated min and max fields are set as well
+// when they are compatible with the old signed comparison.
+func (s *statsAccumulator) statistics(truncateLength int) *parquetformat.Statistics {
+	nullCount := s.nullCount
+	stats := &parquetformat.Statistics{NullCount: &nullCount}
+	stats.MinValue, stats.MaxValue = s.minMax(truncateLength)
+	switch s.typ {
+	case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+	default:
+		if s.order == sortOrderSigned || s.typ == parquetformat.Type_BOOLEAN {
+			stats.Min, stats.Max = stats.MinValue, stats.MaxValue
+		}
+	}
+	return stats
+}
+
+func cloneStatValue(v interface{}) interface{} {
+	if b, ok := v.([]byte); ok {
+		return append([]byte{}, b...)
+	}
+	return v
+}
+
+// encodeStatValue returns PLAIN encoded (without length for byte arrays)
+// value v. Zero floating point values are encoded as -0 for min values and
+// as +0 for max values.
+func encodeStatValue(v interface{}, isMin bool) []byte {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return []byte{1}
+		}
+		return []byte{0}
+	case int32:
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, uint32(v))
+		return b
+	case int64:
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(v))
+		return b
+	case float32:
+		if v == 0 {
+			v = float32(zeroStatValue(isMin))
+		}
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, math.Float32bits(v))
+		return b
+	case float64:
+		if v == 0 {
+			v = zeroStatValue(isMin)
+		}
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+		return b
+	case Int96:
+		return append([]byte{}, v[:]...)
+	case []byte:
+		return v
+	}
+	panic("invalid argument")
+}
+
+func zeroStatValue(isMin bool) float64 {
+	if isMin {
+		return math.Copysign(0, -1)
+	}
+	return 0
+}
+
+// truncateMinValue returns the longest prefix of min that is not longer than
+// n bytes. Valid UTF-8 strings are truncated at a character boundary.
+func truncateMinValue(min []byte, n int, isString bool) []byte {
+	if len(min) <= n {
+		return min
+	}
+	if isString && utf8.Valid(min) {
+		for n > 0 && !utf8.RuneStart(min[n]) {
+			n--
+		}
+	}
+	return min[:n]
+}
+
+// truncateMaxValue returns a value of at most n bytes that is greater than
+// max: a prefix of max with the last byte (or character of a valid UTF-8
+// string) incremented. max is returned as is if it is not longer than n
+// bytes or if there is no such value.
+func truncateMaxValue(max []byte, n int, isString bool) []byte {
+	if len(max) <= n {
+		return max
+	}
+	if isString && utf8.Valid(max) {
+		for n > 0 && !utf8.RuneStart(max[n]) {
+			n--
+		}
+		for b := max[:n]; len(b) > 0; {
+			r, size := utf8.DecodeLastRune(b)
+			b = b[:len(b)-size]
+			if r++; r >= 0xD800 && r <= 0xDFFF {
+				// skip surrogates
+				r = 0xE000
+			}
+			if r > utf8.MaxRune {
+				continue
+			}
+			var buf [utf8.UTFMax]byte
+			size = utf8.EncodeRune(buf[:], r)
+			if len(b)+size <= n {
+				return append(append([]byte{}, b...), buf[:size]...)
+			}
+		}
+		return max
+	}
+	for i := n - 1; i >= 0; i-- {
+		if max[i] != 0xFF {
+			b := append([]byte{}, max[:i+1]...)
+			b[i]++
+			return b
+		}
+	}
+	return max
+}
+
+// boundaryOrder returns the order of min and max values of non-null pages in
+// ci.
+func boundaryOrder(ci *parquetformat.ColumnIndex, typ parquetformat.Type, compare func(a, b interface{}) int) parquetformat.BoundaryOrder {
+	asc, desc := true, true
+	var prevMin, prevMax interface{}
+	for i, null := range ci.NullPages {
+		if null {
+			continue
+		}
+		min := decodeStatValue(typ, ci.MinValues[i])
+		max := decodeStatValue(typ, ci.MaxValues[i])
+		if prevMin != nil {
+			if compare(min, prevMin) < 0 || compare(max, prevMax) < 0 {
+				asc = false
+			}
+			if compare(min, prevMin) > 0 || compare(max, prevMax) > 0 {
+				desc = false
+			}
+		}
+		prevMin, prevMax = min, max
+	}
+	switch {
+	case asc:
+		return parquetformat.BoundaryOrder_ASCENDING
+	case desc:
+		return parquetformat.BoundaryOrder_DESCENDING
+	}
+	return parquetformat.BoundaryOrder_UNORDERED
+}
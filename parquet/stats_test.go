@@ -0,0 +1,206 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func floatStat(v float32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, math.Float32bits(v))
+	return b
+}
+
+func TestTruncateStatValues(t *testing.T) {
+	tests := []struct {
+		value    string
+		n        int
+		isString bool
+		min      string
+		max      string
+	}{
+		{"abc", 3, false, "abc", "abc"},
+		{"abcdef", 3, false, "abc", "abd"},
+		{"ab\xff\xffz", 4, false, "ab\xff\xff", "ac"},
+		{"\xff\xff\xff\xff", 2, false, "\xff\xff", "\xff\xff\xff\xff"},
+		{"abcdef", 0, false, "", "abcdef"},
+
+		{"abcdef", 3, true, "abc", "abd"},
+		{"aé日本", 5, true, "aé", "aê"},
+		{"日本語", 4, true, "日", string([]rune{'日' + 1})},
+		{"a￿b", 4, true, "a￿", "b"},
+		{"a퟿b", 4, true, "a퟿", "a"},
+		{"\U0010FFFF\U0010FFFF", 5, true, "\U0010FFFF", "\U0010FFFF\U0010FFFF"},
+		// invalid UTF-8 strings are truncated as bytes
+		{"ab\xffcd", 3, true, "ab\xff", "ac"},
+	}
+	for _, test := range tests {
+		if got := string(truncateMinValue([]byte(test.value), test.n, test.isString)); got != test.min {
+			t.Errorf("truncateMinValue(%q, %d, %t) = %q, want %q", test.value, test.n, test.isString, got, test.min)
+		}
+		if got := string(truncateMaxValue([]byte(test.value), test.n, test.isString)); got != test.max {
+			t.Errorf("truncateMaxValue(%q, %d, %t) = %q, want %q", test.value, test.n, test.isString, got, test.max)
+		}
+	}
+}
+
+func TestWriteStatistics(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(6)},
+		&pf.SchemaElement{Name: "i", Type: typeInt32, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "u", Type: typeInt32, RepetitionType: frtOptional,
+			ConvertedType: pf.ConvertedTypePtr(pf.ConvertedType_UINT_32)},
+		&pf.SchemaElement{Name: "f", Type: typeFloat, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "b", Type: typeBoolean, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "s", Type: typeByteArray, RepetitionType: frtOptional, ConvertedType: ctUTF8},
+		&pf.SchemaElement{Name: "x", Type: typeInt96, RepetitionType: frtOptional},
+	))
+	nan := float32(math.NaN())
+	negZero := float32(math.Copysign(0, -1))
+	data := [][]interface{}{
+		{int32(5), nil, int32(-3), nil, nil, nil},
+		{int32(-1), int32(1), int32(2), int32(7), nil, int32(0)},
+		{nan, float32(0), float32(2), nan, nil, nil},
+		{true, true, nil, false, true, nil},
+		{[]byte("abcdefgh"), []byte("abc"), nil, []byte("é"), []byte("日本語です"), nil},
+		{Int96{1}, nil, Int96{2}, nil, nil, nil},
+	}
+
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 3
+	fw.StatisticsTruncateLength = 5
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	for _, col := range schema.Columns() {
+		var values []interface{}
+		var dLevels []uint16
+		for _, v := range data[col.Index()] {
+			if v == nil {
+				dLevels = append(dLevels, 0)
+			} else {
+				values = append(values, v)
+				dLevels = append(dLevels, 1)
+			}
+		}
+		if err = fw.WriteColumnChunk(col, values, dLevels, make([]uint16, len(dLevels))); err != nil {
+			t.Fatalf("failed to write column %s: %s", col, err)
+		}
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	stats := func(nulls int64, min, max []byte, deprecated bool) *pf.Statistics {
+		s := &pf.Statistics{NullCount: int64P(nulls), MinValue: min, MaxValue: max}
+		if deprecated {
+			s.Min, s.Max = min, max
+		}
+		return s
+	}
+	tests := []struct {
+		chunk *pf.Statistics
+		pages []*pf.Statistics
+		ci    *pf.ColumnIndex
+	}{
+		{
+			chunk: stats(4, int32Stat(-3), int32Stat(5), true),
+			pages: []*pf.Statistics{stats(1, int32Stat(-3), int32Stat(5), true), stats(3, nil, nil, true)},
+			ci: &pf.ColumnIndex{
+				NullPages:     []bool{false, true},
+				MinValues:     [][]byte{int32Stat(-3), {}},
+				MaxValues:     [][]byte{int32Stat(5), {}},
+				BoundaryOrder: pf.BoundaryOrder_ASCENDING,
+				NullCounts:    []int64{1, 3},
+			},
+		},
+		{
+			chunk: stats(1, int32Stat(0), int32Stat(-1), false),
+			pages: []*pf.Statistics{stats(0, int32Stat(1), int32Stat(-1), false), stats(1, int32Stat(0), int32Stat(7), false)},
+			ci: &pf.ColumnIndex{
+				NullPages:     []bool{false, false},
+				MinValues:     [][]byte{int32Stat(1), int32Stat(0)},
+				MaxValues:     [][]byte{int32Stat(-1), int32Stat(7)},
+				BoundaryOrder: pf.BoundaryOrder_DESCENDING,
+				NullCounts:    []int64{0, 1},
+			},
+		},
+		{
+			// the second page has only NaN values
+			chunk: stats(2, floatStat(negZero), floatStat(2), true),
+			pages: []*pf.Statistics{stats(0, floatStat(negZero), floatStat(2), true), stats(2, nil, nil, true)},
+		},
+		{
+			chunk: stats(2, []byte{0}, []byte{1}, true),
+			pages: []*pf.Statistics{stats(1, []byte{1}, []byte{1}, true), stats(1, []byte{0}, []byte{1}, true)},
+			ci: &pf.ColumnIndex{
+				NullPages:     []bool{false, false},
+				MinValues:     [][]byte{{1}, {0}},
+				MaxValues:     [][]byte{{1}, {1}},
+				BoundaryOrder: pf.BoundaryOrder_DESCENDING,
+				NullCounts:    []int64{1, 1},
+			},
+		},
+		{
+			chunk: stats(2, []byte("abc"), []byte(string([]rune{'日' + 1})), false),
+			pages: []*pf.Statistics{stats(1, []byte("abc"), []byte("abcdf"), false), stats(1, []byte("é"), []byte(string([]rune{'日' + 1})), false)},
+			ci: &pf.ColumnIndex{
+				NullPages:     []bool{false, false},
+				MinValues:     [][]byte{[]byte("abc"), []byte("é")},
+				MaxValues:     [][]byte{[]byte("abcdf"), []byte(string([]rune{'日' + 1}))},
+				BoundaryOrder: pf.BoundaryOrder_ASCENDING,
+				NullCounts:    []int64{1, 1},
+			},
+		},
+		{
+			// INT96 values don't have a defined order
+			chunk: stats(4, nil, nil, false),
+			pages: []*pf.Statistics{stats(1, nil, nil, false), stats(3, nil, nil, false)},
+		},
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	for _, col := range schema.Columns() {
+		test := tests[col.Index()]
+		if got := f.MetaData.RowGroups[0].Columns[col.Index()].MetaData.Statistics; !reflect.DeepEqual(got, test.chunk) {
+			t.Errorf("column %s: got chunk statistics %v, want %v", col, got, test.chunk)
+		}
+
+		cr, err := f.NewReader(col, 0)
+		if err != nil {
+			t.Fatalf("failed to create reader: %s", err)
+		}
+		for i, want := range test.pages {
+			if got := cr.PageHeader().DataPageHeader.Statistics; !reflect.DeepEqual(got, want) {
+				t.Errorf("column %s, page %d: got statistics %v, want %v", col, i, got, want)
+			}
+			if err = cr.SkipPage(); err != nil && err != EndOfChunk {
+				t.Fatalf("column %s: failed to skip page: %s", col, err)
+			}
+		}
+
+		ci, err := f.ReadColumnIndex(col, 0)
+		if err != nil {
+			t.Errorf("column %s: failed to read column index: %s", col, err)
+		} else if !reflect.DeepEqual(ci, test.ci) {
+			t.Errorf("column %s: got column index %v, want %v", col, ci, test.ci)
+		}
+	}
+
+	// statistics written by the writer are used by the reader
+	if d := f.columnChunkStats(schema.Columns()[1], 0); d.min != int32(0) || d.max != int32(-1) || d.nullCount != 1 {
+		t.Errorf("got min %v, max %v, null count %d, want 0, -1, 1", d.min, d.max, d.nullCount)
+	}
+}
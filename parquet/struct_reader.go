@@ -0,0 +1,723 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// StructReader reads records of a parquet file into Go structs.
+//
+// Fields of the schema are mapped to exported fields of a struct by name. A
+// name can be specified with a parquet struct tag (e.g. `parquet:"name"`),
+// otherwise the name of the struct field is used (exact match is preferred,
+// then case-insensitive match). Fields tagged with `parquet:"-"` are ignored.
+// Schema fields without corresponding struct fields are not read.
+//
+// Schema fields are mapped to Go types as follows:
+//
+//   - BOOLEAN: bool
+//   - INT32: an integer type that can hold all values of the column (e.g.
+//     int8 for INT_8, uint32 or int64 for UINT_32, int32 or int64 otherwise),
+//     time.Time for INT32 annotated with DATE
+//   - INT64: int64 or int (if 64 bits wide), uint64 or uint (if 64 bits wide)
+//     for INT64 annotated with UINT_64, time.Time for INT64 annotated with
+//     TIMESTAMP_MILLIS, TIMESTAMP_MICROS or TIMESTAMP (times not adjusted to
+//     UTC are read as UTC times with the same wall clock)
+//   - INT96: Int96
+//   - FLOAT: float32 or float64
+//   - DOUBLE: float64
+//   - BYTE_ARRAY: []byte or string
+//   - FIXED_LEN_BYTE_ARRAY: []byte, string or [N]byte with N equal to the
+//     length of the type
+//   - a group: a struct
+//   - a group annotated with MAP: map[K]V
+//   - a group annotated with LIST: a slice of the list element type
+//   - a REPEATED field: a slice of the field type
+//
+// A pointer to any of the above types can be used, which is useful to
+// distinguish null values of OPTIONAL fields. When a non-pointer type is used
+// for an OPTIONAL field null values are read as zero values.
+type StructReader struct {
+	f   *File
+	typ reflect.Type
+
+	cols []*structColumn
+	rg   int
+	open bool
+	err  error
+
+	// keys of maps in the current record in order they were read
+	mapKeys map[uintptr][]reflect.Value
+}
+
+// NewStructReader creates a StructReader that reads records of f into structs
+// of the same type as proto. proto must be a struct or a pointer to a struct.
+func NewStructReader(f *File, proto interface{}) (*StructReader, error) {
+	t := reflect.TypeOf(proto)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("parquet: %T is not a struct", proto)
+	}
+
+	b := structBinder{schema: f.Schema}
+	if err := b.bindStruct(&f.Schema.root, t, nil, t.Name()); err != nil {
+		return nil, err
+	}
+	if len(b.cols) == 0 {
+		return nil, fmt.Errorf("parquet: %s has no fields matching the schema", t)
+	}
+
+	return &StructReader{
+		f:       f,
+		typ:     t,
+		cols:    b.cols,
+		mapKeys: make(map[uintptr][]reflect.Value),
+	}, nil
+}
+
+// Next reads the next record into dst. dst must be a pointer to a struct of
+// the same type as proto passed to NewStructReader. io.EOF is returned when
+// all records have been read.
+func (sr *StructReader) Next(dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Type() != sr.typ {
+		return fmt.Errorf("parquet: Next requires *%s, got %T", sr.typ, dst)
+	}
+	if sr.err != nil {
+		return sr.err
+	}
+	v = v.Elem()
+	v.Set(reflect.Zero(sr.typ))
+
+	for {
+		if !sr.open {
+			if sr.rg == len(sr.f.MetaData.RowGroups) {
+				sr.err = io.EOF
+				return sr.err
+			}
+			for _, sc := range sr.cols {
+				cr, err := sr.f.NewReader(sc.col, sr.rg)
+				if err != nil {
+					sr.err = err
+					return err
+				}
+				sc.reset(cr)
+			}
+			sr.open = true
+		}
+
+		found, err := sr.readRecord(v)
+		if err != nil {
+			sr.err = err
+			return err
+		}
+		if found {
+			return nil
+		}
+		sr.open = false
+		sr.rg++
+	}
+}
+
+func (sr *StructReader) readRecord(v reflect.Value) (bool, error) {
+	for k := range sr.mapKeys {
+		delete(sr.mapKeys, k)
+	}
+	found := false
+	for i, sc := range sr.cols {
+		ok, err := sc.readRecord(func(d uint16, r uint16, vi int) error {
+			return sc.add(sr, v, sc.steps, d, vi)
+		})
+		if err != nil {
+			return false, err
+		}
+		if i > 0 && ok != found {
+			return false, fmt.Errorf("parquet: columns %s and %s have different number of rows",
+				sr.cols[0].col, sc.col)
+		}
+		found = ok
+	}
+	return found, nil
+}
+
+// Unmarshal reads all records of f into dst which must be a pointer to a slice
+// of structs or a pointer to a slice of pointers to structs. Records are
+// appended to the slice. See StructReader for details how records are mapped to
+// structs.
+func Unmarshal(f *File, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("parquet: Unmarshal requires a pointer to a slice, got %T", dst)
+	}
+	slice := v.Elem()
+	et := slice.Type().Elem()
+	ptr := et.Kind() == reflect.Ptr
+	if ptr {
+		et = et.Elem()
+	}
+
+	sr, err := NewStructReader(f, reflect.Zero(et).Interface())
+	if err != nil {
+		return err
+	}
+	for {
+		rec := reflect.New(et)
+		err = sr.Next(rec.Interface())
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if ptr {
+			slice.Set(reflect.Append(slice, rec))
+		} else {
+			slice.Set(reflect.Append(slice, rec.Elem()))
+		}
+	}
+}
+
+// structStep describes how a schema element on the path to a column is mapped
+// to a Go value.
+type structStep struct {
+	field    int    // index of the struct field or -1 if there is no own field
+	d        uint16 // the definition level when the element is defined
+	r        uint16 // repetition level of the element (if repeated)
+	repeated bool
+	leaf     bool
+	mapKey   bool // the element is a key of a map entry
+}
+
+// structColumn adds values of a single column to structs
+type structColumn struct {
+	recordReader
+	steps []structStep
+	set   func(dst reflect.Value, vi int)
+}
+
+// add adds the value with index vi and definition level d to cur following
+// steps.
+func (sc *structColumn) add(sr *StructReader, cur reflect.Value, steps []structStep, d uint16, vi int) error {
+	s := steps[0]
+	if s.field >= 0 {
+		cur = indirectAlloc(cur).Field(s.field)
+	}
+	if d < s.d {
+		// the element is not defined: leave the zero value
+		return nil
+	}
+	if s.leaf && !s.repeated {
+		sc.set(indirectAlloc(cur), vi)
+		return nil
+	}
+
+	cur = indirectAlloc(cur)
+	switch cur.Kind() {
+	case reflect.Slice:
+		if cur.IsNil() {
+			cur.Set(reflect.MakeSlice(cur.Type(), 0, 0))
+		}
+	case reflect.Map:
+		if cur.IsNil() {
+			cur.Set(reflect.MakeMap(cur.Type()))
+		}
+	}
+	if !s.repeated {
+		return sc.add(sr, cur, steps[1:], d, vi)
+	}
+
+	i := sc.idx[s.r-1]
+	if cur.Kind() == reflect.Map {
+		// cur is a map and steps[1] is either a key or a value of its entry
+		next := steps[1]
+		p := cur.Pointer()
+		keys := sr.mapKeys[p]
+		if next.mapKey {
+			if i != len(keys) {
+				return fmt.Errorf("parquet: invalid repetition level in column %s", sc.col)
+			}
+			k := reflect.New(cur.Type().Key()).Elem()
+			if d >= next.d {
+				sc.set(indirectAlloc(k), vi)
+			}
+			sr.mapKeys[p] = append(keys, k)
+			cur.SetMapIndex(k, reflect.Zero(cur.Type().Elem()))
+			return nil
+		}
+		if i >= len(keys) {
+			return fmt.Errorf("parquet: no map key for a value in column %s", sc.col)
+		}
+		// map values are not addressable, modify a copy
+		e := reflect.New(cur.Type().Elem()).Elem()
+		e.Set(cur.MapIndex(keys[i]))
+		if err := sc.add(sr, e, steps[1:], d, vi); err != nil {
+			return err
+		}
+		cur.SetMapIndex(keys[i], e)
+		return nil
+	}
+
+	switch n := cur.Len(); {
+	case i == n:
+		cur.Set(reflect.Append(cur, reflect.Zero(cur.Type().Elem())))
+	case i > n || s.leaf:
+		return fmt.Errorf("parquet: invalid repetition level in column %s", sc.col)
+	}
+	if s.leaf {
+		sc.set(indirectAlloc(cur.Index(i)), vi)
+		return nil
+	}
+	return sc.add(sr, cur.Index(i), steps[1:], d, vi)
+}
+
+// indirectAlloc follows pointers allocating new values for nil pointers.
+func indirectAlloc(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// structBinder maps schema elements to fields of Go structs.
+type structBinder struct {
+	schema Schema
+	names  []string
+	cols   []*structColumn
+}
+
+// bindStruct binds children of g to fields of struct t.
+func (b *structBinder) bindStruct(g *group, t reflect.Type, steps []structStep, goPath string) error {
+	for _, child := range g.children {
+		s := elementOf(child)
+		i, ok := structFieldByName(t, s.Name)
+		if !ok {
+			continue
+		}
+		f := t.Field(i)
+		step := newStructStep(steps, s)
+		step.field = i
+		if err := b.bindElement(child, f.Type, steps, step, goPath+"."+f.Name, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindElement binds schema element e to Go type t. inList is true when e is
+// the repeated element of a group annotated with LIST.
+func (b *structBinder) bindElement(e schemaElement, t reflect.Type, steps []structStep, step structStep, goPath string, inList bool) error {
+	s := elementOf(e)
+	b.names = append(b.names, s.Name)
+	defer func() { b.names = b.names[:len(b.names)-1] }()
+
+	steps = append(steps[:len(steps):len(steps)], step)
+	t = indirectType(t)
+	if step.repeated {
+		if t.Kind() != reflect.Slice {
+			return fmt.Errorf("parquet: cannot read repeated field %s into %s of type %s",
+				strings.Join(b.names, "."), goPath, t)
+		}
+		t = indirectType(t.Elem())
+	}
+
+	g, ok := e.(*group)
+	if !ok {
+		col, _ := b.schema.ColumnByPath(b.names)
+		steps[len(steps)-1].leaf = true
+		sc := &structColumn{steps: steps}
+		values := newTypedValues(col, rowReaderBatchSize)
+		sc.init(col, values)
+		sc.set = newStructValueSetter(col, t, values)
+		if sc.set == nil {
+			return fmt.Errorf("parquet: cannot read column %s (%s) into %s of type %s",
+				col, col.Type(), goPath, t)
+		}
+		b.cols = append(b.cols, sc)
+		return nil
+	}
+
+	switch {
+	case inList && isListElementWrapper(g, b.names):
+		// 3-level list: the only child of the repeated group is the element
+		child := g.children[0]
+		cs := newStructStep(steps, elementOf(child))
+		cs.field = -1
+		return b.bindElement(child, t, steps, cs, goPath, false)
+
+	case !step.repeated && t.Kind() == reflect.Slice && isListGroup(g):
+		child := g.children[0]
+		cs := newStructStep(steps, elementOf(child))
+		cs.field = -1
+		return b.bindElement(child, t, steps, cs, goPath, true)
+
+	case !step.repeated && t.Kind() == reflect.Map && isMapGroup(g):
+		kv := g.children[0].(*group)
+		kvs := newStructStep(steps, kv.schemaElement)
+		kvs.field = -1
+		b.names = append(b.names, kv.schemaElement.Name)
+		defer func() { b.names = b.names[:len(b.names)-1] }()
+		steps = append(steps, kvs)
+
+		ks := newStructStep(steps, elementOf(kv.children[0]))
+		ks.field = -1
+		ks.mapKey = true
+		if err := b.bindElement(kv.children[0], t.Key(), steps, ks, goPath+"[key]", false); err != nil {
+			return err
+		}
+		if len(kv.children) == 2 {
+			vs := newStructStep(steps, elementOf(kv.children[1]))
+			vs.field = -1
+			return b.bindElement(kv.children[1], t.Elem(), steps, vs, goPath+"[value]", false)
+		}
+		return nil
+
+	case t.Kind() == reflect.Struct:
+		return b.bindStruct(g, t, steps, goPath)
+	}
+
+	return fmt.Errorf("parquet: cannot read group %s into %s of type %s",
+		strings.Join(b.names, "."), goPath, t)
+}
+
+func newStructStep(steps []structStep, s *parquetformat.SchemaElement) structStep {
+	var step structStep
+	if len(steps) > 0 {
+		step.d = steps[len(steps)-1].d
+		step.r = steps[len(steps)-1].r
+	}
+	switch *s.RepetitionType {
+	case parquetformat.FieldRepetitionType_OPTIONAL:
+		step.d++
+	case parquetformat.FieldRepetitionType_REPEATED:
+		step.d++
+		step.r++
+		step.repeated = true
+	}
+	return step
+}
+
+func elementOf(e schemaElement) *parquetformat.SchemaElement {
+	switch e := e.(type) {
+	case *group:
+		return e.schemaElement
+	case *primitive:
+		return e.schemaElement
+	}
+	panic("unexpected schema element type")
+}
+
+// isListGroup returns true if g is annotated with LIST and has a single
+// repeated child.
+func isListGroup(g *group) bool {
+	s := g.schemaElement
+	if s.ConvertedType == nil || *s.ConvertedType != parquetformat.ConvertedType_LIST || len(g.children) != 1 {
+		return false
+	}
+	return *elementOf(g.children[0]).RepetitionType == parquetformat.FieldRepetitionType_REPEATED
+}
+
+// isListElementWrapper returns true if the repeated group g of a list wraps
+// the list element (3-level list structure). names is the path to g.
+//
+// Backward compatibility rules for older files: if the repeated group has
+// multiple children or is named "array" or "<list-name>_tuple" then it is the
+// element itself.
+func isListElementWrapper(g *group, names []string) bool {
+	if len(g.children) != 1 {
+		return false
+	}
+	name := g.schemaElement.Name
+	if name == "array" {
+		return false
+	}
+	if len(names) >= 2 && name == names[len(names)-2]+"_tuple" {
+		return false
+	}
+	return true
+}
+
+// isMapGroup returns true if g is annotated with MAP (or MAP_KEY_VALUE) and
+// has a single repeated group child with a key and optionally a value.
+func isMapGroup(g *group) bool {
+	s := g.schemaElement
+	if s.ConvertedType == nil || len(g.children) != 1 {
+		return false
+	}
+	if ct := *s.ConvertedType; ct != parquetformat.ConvertedType_MAP && ct != parquetformat.ConvertedType_MAP_KEY_VALUE {
+		return false
+	}
+	kv, ok := g.children[0].(*group)
+	if !ok || *kv.schemaElement.RepetitionType != parquetformat.FieldRepetitionType_REPEATED {
+		return false
+	}
+	if len(kv.children) != 1 && len(kv.children) != 2 {
+		return false
+	}
+	_, ok = kv.children[0].(*primitive)
+	return ok
+}
+
+// structFieldByName returns the index of an exported field of struct t that
+// corresponds to a schema field with the given name.
+func structFieldByName(t reflect.Type, name string) (int, bool) {
+	found := -1
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		tag, _ := parseStructTag(f.Tag.Get("parquet"))
+		switch {
+		case tag == "-":
+			continue
+		case tag != "":
+			if tag == name {
+				return i, true
+			}
+		case f.Name == name:
+			return i, true
+		case found < 0 && strings.EqualFold(f.Name, name):
+			found = i
+		}
+	}
+	return found, found >= 0
+}
+
+// parseStructTag splits a parquet struct tag into a name and options.
+func parseStructTag(tag string) (name string, opts []string) {
+	// commas inside parentheses don't separate options, so option values
+	// can have parameters
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range tag {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, tag[start:])
+	return parts[0], parts[1:]
+}
+
+// newTypedValues creates a slice of n elements of the type that corresponds to
+// the type of col.
+func newTypedValues(col Column, n int) interface{} {
+	switch col.Type() {
+	case parquetformat.Type_BOOLEAN:
+		return make([]bool, n)
+	case parquetformat.Type_INT32:
+		return make([]int32, n)
+	case parquetformat.Type_INT64:
+		return make([]int64, n)
+	case parquetformat.Type_INT96:
+		return make([]Int96, n)
+	case parquetformat.Type_FLOAT:
+		return make([]float32, n)
+	case parquetformat.Type_DOUBLE:
+		return make([]float64, n)
+	case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		return make([][]byte, n)
+	}
+	panic("unknown type")
+}
+
+var (
+	int96Type = reflect.TypeOf(Int96{})
+	timeType  = reflect.TypeOf(time.Time{})
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// convertedType returns the converted type of col or -1 if col is not
+// annotated.
+func convertedType(col Column) parquetformat.ConvertedType {
+	if ct := col.schemaElement.ConvertedType; ct != nil {
+		return *ct
+	}
+	return -1
+}
+
+// timestampUnit returns the unit of values of INT64 column col annotated as a
+// timestamp and whether they are adjusted to UTC. unit is 0 if col is not a
+// timestamp.
+func timestampUnit(col Column) (unit time.Duration, utc bool) {
+	if lt := col.schemaElement.LogicalType; lt != nil && lt.TIMESTAMP != nil {
+		switch u := lt.TIMESTAMP.Unit; {
+		case u == nil:
+		case u.MILLIS != nil:
+			return time.Millisecond, lt.TIMESTAMP.IsAdjustedToUTC
+		case u.MICROS != nil:
+			return time.Microsecond, lt.TIMESTAMP.IsAdjustedToUTC
+		}
+		return 0, false
+	}
+	switch convertedType(col) {
+	case parquetformat.ConvertedType_TIMESTAMP_MILLIS:
+		return time.Millisecond, true
+	case parquetformat.ConvertedType_TIMESTAMP_MICROS:
+		return time.Microsecond, true
+	}
+	return 0, false
+}
+
+// timestampToTime converts timestamp v in the given unit to a UTC time. v is
+// split into seconds and a fraction of a second, so that times outside of the
+// range of int64 nanoseconds (e.g. 9999-12-31) don't overflow.
+func timestampToTime(v int64, unit time.Duration) time.Time {
+	perSec := int64(time.Second / unit)
+	sec, frac := v/perSec, v%perSec
+	if frac < 0 {
+		sec--
+		frac += perSec
+	}
+	return time.Unix(sec, frac*int64(unit)).UTC()
+}
+
+// int32BitWidth returns the bit width and signedness of values of INT32
+// column col defined by its logical (or converted) type.
+func int32BitWidth(col Column) (bits int, signed bool) {
+	if lt := col.schemaElement.LogicalType; lt != nil && lt.INTEGER != nil {
+		return int(lt.INTEGER.BitWidth), lt.INTEGER.IsSigned
+	}
+	switch convertedType(col) {
+	case parquetformat.ConvertedType_INT_8:
+		return 8, true
+	case parquetformat.ConvertedType_INT_16:
+		return 16, true
+	case parquetformat.ConvertedType_UINT_8:
+		return 8, false
+	case parquetformat.ConvertedType_UINT_16:
+		return 16, false
+	case parquetformat.ConvertedType_UINT_32:
+		return 32, false
+	}
+	return 32, true
+}
+
+// int64BitWidth returns the bit width and signedness of values of INT64
+// column col defined by its logical (or converted) type.
+func int64BitWidth(col Column) (bits int, signed bool) {
+	if lt := col.schemaElement.LogicalType; lt != nil && lt.INTEGER != nil {
+		return int(lt.INTEGER.BitWidth), lt.INTEGER.IsSigned
+	}
+	if convertedType(col) == parquetformat.ConvertedType_UINT_64 {
+		return 64, false
+	}
+	return 64, true
+}
+
+// isSignedInt returns true if t is a signed Go integer type.
+func isSignedInt(t reflect.Type) bool {
+	return t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64
+}
+
+// integerFits returns true if all integers of the given bit width and
+// signedness can be stored in t without loss.
+func integerFits(bits int, signed bool, t reflect.Type) bool {
+	switch {
+	case isSignedInt(t) && signed:
+		return bits <= t.Bits()
+	case isSignedInt(t):
+		return bits < t.Bits()
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64:
+		return !signed && bits <= t.Bits()
+	}
+	return false
+}
+
+// newStructValueSetter returns a function that stores a value from values
+// (created by newTypedValues) into a Go value of type t. nil is returned if
+// values of col cannot be stored in t.
+func newStructValueSetter(col Column, t reflect.Type, values interface{}) func(dst reflect.Value, vi int) {
+	switch values := values.(type) {
+	case []bool:
+		if t.Kind() == reflect.Bool {
+			return func(dst reflect.Value, vi int) { dst.SetBool(values[vi]) }
+		}
+	case []int32:
+		if t == timeType && convertedType(col) == parquetformat.ConvertedType_DATE {
+			return func(dst reflect.Value, vi int) {
+				dst.Set(reflect.ValueOf(time.Unix(int64(values[vi])*secondsPerDay, 0).UTC()))
+			}
+		}
+		bits, signed := int32BitWidth(col)
+		switch {
+		case !integerFits(bits, signed, t):
+		case isSignedInt(t) && signed:
+			return func(dst reflect.Value, vi int) { dst.SetInt(int64(values[vi])) }
+		case isSignedInt(t):
+			return func(dst reflect.Value, vi int) { dst.SetInt(int64(uint32(values[vi]))) }
+		default:
+			return func(dst reflect.Value, vi int) { dst.SetUint(uint64(uint32(values[vi]))) }
+		}
+	case []int64:
+		if t == timeType {
+			// timestamps not adjusted to UTC are read as UTC times with the
+			// same wall clock
+			if unit, _ := timestampUnit(col); unit != 0 {
+				return func(dst reflect.Value, vi int) {
+					dst.Set(reflect.ValueOf(timestampToTime(values[vi], unit)))
+				}
+			}
+		}
+		bits, signed := int64BitWidth(col)
+		switch {
+		case !integerFits(bits, signed, t):
+		case isSignedInt(t):
+			return func(dst reflect.Value, vi int) { dst.SetInt(values[vi]) }
+		default:
+			return func(dst reflect.Value, vi int) { dst.SetUint(uint64(values[vi])) }
+		}
+	case []Int96:
+		if t == int96Type {
+			return func(dst reflect.Value, vi int) { dst.Set(reflect.ValueOf(values[vi])) }
+		}
+	case []float32:
+		switch t.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return func(dst reflect.Value, vi int) { dst.SetFloat(float64(values[vi])) }
+		}
+	case []float64:
+		if t.Kind() == reflect.Float64 {
+			return func(dst reflect.Value, vi int) { dst.SetFloat(values[vi]) }
+		}
+	case [][]byte:
+		switch {
+		case t.Kind() == reflect.String:
+			return func(dst reflect.Value, vi int) { dst.SetString(string(values[vi])) }
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			return func(dst reflect.Value, vi int) { dst.SetBytes(values[vi]) }
+		case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8 &&
+			col.Type() == parquetformat.Type_FIXED_LEN_BYTE_ARRAY &&
+			t.Len() == int(*col.schemaElement.TypeLength):
+			return func(dst reflect.Value, vi int) { reflect.Copy(dst, reflect.ValueOf(values[vi])) }
+		}
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+package parquet
+
+import (
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func int32P(v int32) *int32    { return &v }
+func int64P(v int64) *int64    { return &v }
+func stringP(v string) *string { return &v }
+
+type writerTestStruct struct {
+	I32 int32  `parquet:"i32"`
+	I64 *int64 `parquet:"i64"`
+	B   []bool `parquet:"b"`
+	G   *struct {
+		F float32
+		D []float64
+		S *string
+	} `parquet:"g"`
+	R struct {
+		I96 Int96
+	}
+	Fixed   *[3]byte `parquet:"fixed"`
+	Empty   *int     `parquet:"empty"`
+	Ignored string   `parquet:"-"`
+	private int
+}
+
+func TestUnmarshal(t *testing.T) {
+	f := createTestFile(t, writerTestMeta, writerTestData)
+
+	var got []writerTestStruct
+	if err := Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+
+	want := make([]writerTestStruct, 4)
+	want[0].I32 = 1
+	want[0].I64 = int64P(10)
+	want[0].B = []bool{true, false}
+	want[0].G = &struct {
+		F float32
+		D []float64
+		S *string
+	}{1.5, []float64{1, 2}, stringP("abc")}
+	want[0].R.I96 = Int96{1}
+	want[0].Fixed = &[3]byte{'x', 'y', 'z'}
+
+	want[1].I32 = 2
+	want[1].R.I96 = Int96{2}
+	want[1].Fixed = &[3]byte{'1', '2', '3'}
+
+	want[2].I32 = -3
+	want[2].I64 = int64P(-30)
+	want[2].B = []bool{true}
+	want[2].G = &struct {
+		F float32
+		D []float64
+		S *string
+	}{F: 3.5}
+	want[2].R.I96 = Int96{3}
+
+	want[3].I32 = 4
+	want[3].I64 = int64P(40)
+	want[3].B = []bool{false, false, true}
+	want[3].G = &struct {
+		F float32
+		D []float64
+		S *string
+	}{F: 4.5, S: stringP("")}
+	want[3].R.I96 = Int96{4}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+type dremelDocument struct {
+	DocID int64 `parquet:"DocId"`
+	Links *struct {
+		Backward []int64
+		Forward  []int64
+	}
+	Name []struct {
+		Language []*struct {
+			Code    string
+			Country *string
+		}
+		URL *string `parquet:"Url"`
+	}
+}
+
+func TestStructReaderNested(t *testing.T) {
+	f := createTestFile(t, dremelPaperExampleMeta, dremelPaperExampleData)
+
+	sr, err := NewStructReader(f, &dremelDocument{})
+	if err != nil {
+		t.Fatalf("failed to create struct reader: %s", err)
+	}
+
+	var doc dremelDocument
+	if err = sr.Next(&doc); err != nil {
+		t.Fatalf("failed to read the first record: %s", err)
+	}
+	if doc.DocID != 10 || doc.Links == nil ||
+		len(doc.Links.Backward) != 0 || !reflect.DeepEqual(doc.Links.Forward, []int64{20, 40, 60}) {
+		t.Errorf("invalid DocId or Links: %+v", doc)
+	}
+	if len(doc.Name) != 3 {
+		t.Fatalf("got %d names, want 3", len(doc.Name))
+	}
+	if n := doc.Name[0]; len(n.Language) != 2 || n.Language[0].Code != "en-us" || *n.Language[0].Country != "us" ||
+		n.Language[1].Code != "en" || n.Language[1].Country != nil || *n.URL != "http://A" {
+		t.Errorf("invalid Name[0]: %+v", n)
+	}
+	if n := doc.Name[1]; len(n.Language) != 0 || *n.URL != "http://B" {
+		t.Errorf("invalid Name[1]: %+v", n)
+	}
+	if n := doc.Name[2]; len(n.Language) != 1 || n.Language[0].Code != "en-gb" || *n.Language[0].Country != "gb" ||
+		n.URL != nil {
+		t.Errorf("invalid Name[2]: %+v", n)
+	}
+
+	// the same struct is reused
+	if err = sr.Next(&doc); err != nil {
+		t.Fatalf("failed to read the second record: %s", err)
+	}
+	want := dremelDocument{DocID: 20}
+	want.Links = &struct {
+		Backward []int64
+		Forward  []int64
+	}{[]int64{10, 30}, []int64{80}}
+	if !reflect.DeepEqual(doc, want) {
+		t.Errorf("got %+v, want %+v", doc, want)
+	}
+
+	if err = sr.Next(&doc); err != io.EOF {
+		t.Errorf("got %v, want io.EOF", err)
+	}
+}
+
+var mapListTestMeta = createFileMetaData(
+	&pf.SchemaElement{Name: "m", NumChildren: int32Ptr(2)},
+	&pf.SchemaElement{Name: "tags", RepetitionType: frtOptional, ConvertedType: ctMap, NumChildren: int32Ptr(1)},
+	&pf.SchemaElement{Name: "key_value", RepetitionType: frtRepeated, NumChildren: int32Ptr(2)},
+	&pf.SchemaElement{Name: "key", Type: typeByteArray, RepetitionType: frtRequired, ConvertedType: ctUTF8},
+	&pf.SchemaElement{Name: "value", Type: typeInt32, RepetitionType: frtOptional},
+	&pf.SchemaElement{Name: "list", RepetitionType: frtOptional, ConvertedType: ctList, NumChildren: int32Ptr(1)},
+	&pf.SchemaElement{Name: "list", RepetitionType: frtRepeated, NumChildren: int32Ptr(1)},
+	&pf.SchemaElement{Name: "element", Type: typeByteArray, RepetitionType: frtOptional, ConvertedType: ctUTF8},
+)
+
+var mapListTestData = [][][]cell{{
+	// tags.key_value.key
+	{{2, 0, []byte("a")}, {2, 1, []byte("b")}, {0, 0, nil}, {1, 0, nil}},
+	// tags.key_value.value
+	{{3, 0, int32(1)}, {2, 1, nil}, {0, 0, nil}, {1, 0, nil}},
+	// list.list.element
+	{{3, 0, []byte("x")}, {2, 1, nil}, {1, 0, nil}, {0, 0, nil}},
+}}
+
+func TestUnmarshalMapList(t *testing.T) {
+	f := createTestFile(t, mapListTestMeta, mapListTestData)
+
+	type mapList struct {
+		Tags map[string]*int32
+		List []*string
+	}
+	var got []*mapList
+	if err := Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	want := []*mapList{
+		{Tags: map[string]*int32{"a": int32P(1), "b": nil}, List: []*string{stringP("x"), nil}},
+		{Tags: nil, List: []*string{}},
+		{Tags: map[string]*int32{}, List: nil},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// without LIST and MAP support
+	type rawMapList struct {
+		Tags *struct {
+			KeyValue []struct {
+				Key   []byte
+				Value int32
+			} `parquet:"key_value"`
+		}
+		List *struct {
+			List []struct {
+				Element string
+			}
+		}
+	}
+	var raw []rawMapList
+	if err := Unmarshal(f, &raw); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if len(raw) != 3 || len(raw[0].Tags.KeyValue) != 2 || string(raw[0].Tags.KeyValue[1].Key) != "b" ||
+		raw[0].List.List[0].Element != "x" || raw[1].Tags != nil || raw[2].List != nil {
+		t.Errorf("unexpected raw records: %+v", raw)
+	}
+}
+
+func TestUnmarshalTimestamps(t *testing.T) {
+	meta := createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(2)},
+		&pf.SchemaElement{Name: "ms", Type: typeInt64, RepetitionType: frtRequired,
+			ConvertedType: pf.ConvertedTypePtr(pf.ConvertedType_TIMESTAMP_MILLIS)},
+		&pf.SchemaElement{Name: "us", Type: typeInt64, RepetitionType: frtRequired,
+			ConvertedType: pf.ConvertedTypePtr(pf.ConvertedType_TIMESTAMP_MICROS)},
+	)
+	// outside of the range of int64 nanoseconds (1677-09-21 - 2262-04-11)
+	times := []time.Time{
+		time.Date(9999, 12, 31, 23, 59, 59, 999999000, time.UTC),
+		time.Date(1600, 1, 1, 0, 0, 0, 1000, time.UTC),
+		time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Unix(-1, 999999000).UTC(),
+	}
+	var ms, us []cell
+	for _, tm := range times {
+		ms = append(ms, cell{0, 0, tm.Unix()*1e3 + int64(tm.Nanosecond())/1e6})
+		us = append(us, cell{0, 0, tm.Unix()*1e6 + int64(tm.Nanosecond())/1e3})
+	}
+	f := createTestFile(t, meta, [][][]cell{{ms, us}})
+
+	type rec struct {
+		MS time.Time
+		US time.Time
+	}
+	var got []rec
+	if err := Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if len(got) != len(times) {
+		t.Fatalf("got %d records, want %d", len(got), len(times))
+	}
+	for i, tm := range times {
+		if want := tm.Truncate(time.Millisecond); !got[i].MS.Equal(want) {
+			t.Errorf("record %d: got ms %s, want %s", i, got[i].MS, want)
+		}
+		if !got[i].US.Equal(tm) {
+			t.Errorf("record %d: got us %s, want %s", i, got[i].US, tm)
+		}
+	}
+}
+
+func TestUnmarshalUnsigned(t *testing.T) {
+	meta := createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(2)},
+		&pf.SchemaElement{Name: "u32", Type: typeInt32, RepetitionType: frtRequired,
+			ConvertedType: pf.ConvertedTypePtr(pf.ConvertedType_UINT_32)},
+		&pf.SchemaElement{Name: "u64", Type: typeInt64, RepetitionType: frtRequired,
+			ConvertedType: pf.ConvertedTypePtr(pf.ConvertedType_UINT_64)},
+	)
+	f := createTestFile(t, meta, [][][]cell{{
+		{{0, 0, int32(-1)}},
+		{{0, 0, int64(-1)}},
+	}})
+
+	type rec struct {
+		U32 int64
+		U64 uint64
+	}
+	var got []rec
+	if err := Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if want := []rec{{math.MaxUint32, math.MaxUint64}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// unsigned values don't fit into signed types of the same size
+	for _, proto := range []interface{}{
+		struct{ U32 int32 }{},
+		struct{ U32 uint16 }{},
+		struct{ U64 int64 }{},
+	} {
+		if _, err := NewStructReader(f, proto); err == nil {
+			t.Errorf("%T: error expected", proto)
+		}
+	}
+}
+
+func TestStructReaderErrors(t *testing.T) {
+	f := createTestFile(t, writerTestMeta, writerTestData)
+
+	tests := []struct {
+		proto interface{}
+		err   string
+	}{
+		{1, "not a struct"},
+		{struct{ X int }{}, "no fields matching"},
+		{struct{ I32 string }{}, "column i32 (INT32)"},
+		// i32 is not annotated with INT_8 or UINT_16, values may not fit
+		{struct{ I32 int8 }{}, "column i32 (INT32)"},
+		{struct{ I32 uint16 }{}, "column i32 (INT32)"},
+		// signed values don't fit into unsigned types
+		{struct{ I32 uint32 }{}, "column i32 (INT32)"},
+		{struct{ I32 uint64 }{}, "column i32 (INT32)"},
+		{struct{ I64 uint64 }{}, "column i64 (INT64)"},
+		{struct{ I64 int32 }{}, "column i64 (INT64)"},
+		{struct{ B bool }{}, "repeated field b"},
+		{struct{ G int }{}, "group g"},
+		{struct{ Fixed [4]byte }{}, "column fixed"},
+	}
+	for _, test := range tests {
+		_, err := NewStructReader(f, test.proto)
+		if err == nil {
+			t.Errorf("%T: error expected", test.proto)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%T: got error %q, want error containing %q", test.proto, err, test.err)
+		}
+	}
+
+	sr, err := NewStructReader(f, writerTestStruct{})
+	if err != nil {
+		t.Fatalf("failed to create struct reader: %s", err)
+	}
+	if err = sr.Next(writerTestStruct{}); err == nil {
+		t.Errorf("error expected passing a non-pointer to Next")
+	}
+	var i int
+	if err = Unmarshal(f, &i); err == nil {
+		t.Errorf("error expected unmarshalling into *int")
+	}
+}
@@ -0,0 +1,1184 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// DefaultRowGroupSize is the default approximate size of a row group written
+// by StructWriter.
+const DefaultRowGroupSize = 128 * 1024 * 1024
+
+// number of levels buffered by StructWriter for a column before they are
+// passed to ColumnChunkWriter
+const structWriterBatchSize = 1024
+
+// StructWriter writes Go structs to a parquet file. The schema of the file is
+// inferred from the struct type:
+//
+//   - bool: BOOLEAN
+//   - int8, int16, int32, uint8, uint16, uint32: INT32 (annotated with
+//     INT_8, INT_16, UINT_8, UINT_16, UINT_32 where appropriate)
+//   - int, int64, uint, uint64: INT64 (uint and uint64 are annotated with
+//     UINT_64)
+//   - float32: FLOAT
+//   - float64: DOUBLE
+//   - string: BYTE_ARRAY annotated with UTF8
+//   - []byte: BYTE_ARRAY
+//   - [N]byte: FIXED_LEN_BYTE_ARRAY with length N
+//   - Int96: INT96
+//   - time.Time: INT64 annotated with TIMESTAMP_MILLIS
+//   - a struct: a group
+//   - map[K]V: a group annotated with MAP, nil maps are written as nulls
+//   - a pointer: an OPTIONAL field
+//   - a slice (other than []byte): a REPEATED field
+//
+// Other fields are REQUIRED. Unexported fields and fields tagged with
+// `parquet:"-"` are ignored.
+//
+// A parquet struct tag can be used to override the name of the field and to
+// specify additional options separated by commas:
+//
+//   - type=<converted type>: the converted type of the field, e.g. type=JSON
+//     or type=TIMESTAMP_MICROS (use type=NONE to remove the default one); the
+//     matching logical type is written as well
+//   - logical=<logical type>: the logical type of the field, one of STRING,
+//     ENUM, JSON, BSON, DATE, UUID, INT(<bit width>,<signed>),
+//     TIME(<MILLIS|MICROS>[,<adjusted to UTC>]),
+//     TIMESTAMP(<MILLIS|MICROS>[,<adjusted to UTC>]) or
+//     DECIMAL(<precision>[,<scale>]); the matching converted type (if any) is
+//     written as well
+//   - encoding=<encoding>: the encoding of the values of the field
+//   - compression=<codec>: the compression codec of the column chunks of the
+//     field
+//
+// For example:
+//
+//	type Event struct {
+//		ID      int64     `parquet:"id"`
+//		Time    time.Time `parquet:"ts,type=TIMESTAMP_MICROS"`
+//		Payload string    `parquet:"payload,type=JSON,compression=GZIP"`
+//		Price   int64     `parquet:"price,logical=DECIMAL(12,2)"`
+//	}
+//
+// Records are buffered in memory until the size of the current row group
+// reaches the threshold specified with WithRowGroupSize option.
+type StructWriter struct {
+	fw  *FileWriter
+	typ reflect.Type
+
+	root         *structNode
+	cols         []*structColumnWriter
+	rowGroupSize int64
+	started      bool
+	err          error
+}
+
+// StructWriterOption is an option of StructWriter.
+type StructWriterOption func(sw *StructWriter)
+
+// WithRowGroupSize sets the approximate size (in bytes) of row groups.
+// DefaultRowGroupSize is used by default.
+func WithRowGroupSize(size int64) StructWriterOption {
+	return func(sw *StructWriter) {
+		sw.rowGroupSize = size
+	}
+}
+
+// WithPageSize sets the approximate size (in bytes) of data pages.
+func WithPageSize(size int) StructWriterOption {
+	return func(sw *StructWriter) {
+		sw.fw.PageSize = size
+	}
+}
+
+// WithPageRowCount sets the maximum number of rows in a data page.
+func WithPageRowCount(n int) StructWriterOption {
+	return func(sw *StructWriter) {
+		sw.fw.PageRowCount = n
+	}
+}
+
+// WithCompression sets the default compression codec. It can be overridden
+// for individual fields with struct tags.
+func WithCompression(codec parquetformat.CompressionCodec) StructWriterOption {
+	return func(sw *StructWriter) {
+		sw.fw.Compression = codec
+	}
+}
+
+// NewStructWriter creates a StructWriter that writes structs of the same type
+// as proto to w. proto must be a struct or a pointer to a struct.
+func NewStructWriter(w io.Writer, proto interface{}, opts ...StructWriterOption) (*StructWriter, error) {
+	t := reflect.TypeOf(proto)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("parquet: %T is not a struct", proto)
+	}
+
+	name := t.Name()
+	if name == "" {
+		name = "schema"
+	}
+	root := &structNode{name: name, field: -1, rep: parquetformat.FieldRepetitionType_REQUIRED}
+	if err := root.createGroup(t, nil); err != nil {
+		return nil, err
+	}
+
+	schemaElements := root.schemaElements(nil)
+	schemaElements[0].RepetitionType = nil
+	schema, err := MakeSchema(&parquetformat.FileMetaData{Schema: schemaElements})
+	if err != nil {
+		return nil, fmt.Errorf("parquet: invalid schema created for %s: %s", t, err)
+	}
+
+	fw, err := NewFileWriter(w, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	sw := &StructWriter{
+		fw:           fw,
+		typ:          t,
+		root:         root,
+		rowGroupSize: DefaultRowGroupSize,
+	}
+	sw.cols = root.collectColumns(schema, nil, nil)
+	for _, opt := range opts {
+		opt(sw)
+	}
+	return sw, nil
+}
+
+// Schema returns the schema inferred from the struct type.
+func (sw *StructWriter) Schema() Schema {
+	return sw.fw.Schema
+}
+
+// Write writes a single record. record must be a struct or a pointer to a
+// struct of the same type as proto passed to NewStructWriter.
+func (sw *StructWriter) Write(record interface{}) error {
+	if sw.err != nil {
+		return sw.err
+	}
+	v := reflect.ValueOf(record)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Type() != sw.typ {
+		return fmt.Errorf("parquet: Write requires %s, got %T", sw.typ, record)
+	}
+
+	if !sw.started {
+		if err := sw.startRowGroup(); err != nil {
+			sw.err = err
+			return err
+		}
+	}
+
+	if err := sw.validateValue(sw.root, v); err != nil {
+		return err
+	}
+	if err := sw.writeValue(sw.root, v, 0); err != nil {
+		sw.err = err
+		return err
+	}
+
+	var size int64
+	for _, c := range sw.cols {
+		if len(c.dLevels) >= structWriterBatchSize {
+			if err := c.flush(); err != nil {
+				sw.err = err
+				return err
+			}
+		}
+		size += c.cw.bufferedSize() + c.size
+	}
+	if size >= sw.rowGroupSize {
+		return sw.Flush()
+	}
+	return nil
+}
+
+// Flush finishes the current row group (if any) and writes it to the
+// underlying io.Writer.
+func (sw *StructWriter) Flush() error {
+	if sw.err != nil {
+		return sw.err
+	}
+	if !sw.started {
+		return nil
+	}
+	sw.started = false
+	for _, c := range sw.cols {
+		if err := c.flush(); err != nil {
+			sw.err = err
+			return err
+		}
+		if err := c.cw.Close(); err != nil {
+			sw.err = err
+			return err
+		}
+	}
+	return nil
+}
+
+// Close flushes all buffered records and writes the file metadata. The
+// underlying io.Writer is not closed.
+func (sw *StructWriter) Close() error {
+	if err := sw.Flush(); err != nil {
+		return err
+	}
+	sw.err = fmt.Errorf("parquet: struct writer is closed")
+	return sw.fw.Close()
+}
+
+func (sw *StructWriter) startRowGroup() error {
+	if err := sw.fw.StartRowGroup(); err != nil {
+		return err
+	}
+	for _, c := range sw.cols {
+		cw, err := sw.fw.NewWriter(c.col)
+		if err != nil {
+			return err
+		}
+		if c.encoding != nil {
+			if err = cw.SetEncoding(*c.encoding); err != nil {
+				return err
+			}
+		}
+		if c.codec != nil {
+			if err = cw.SetCompression(*c.codec); err != nil {
+				return err
+			}
+		}
+		c.cw = cw
+	}
+	sw.started = true
+	return nil
+}
+
+// validate checks that v of node n can be written without errors. Records are
+// validated before they are written so that a record is either written
+// completely or not written at all.
+func (sw *StructWriter) validate(n *structNode, v reflect.Value) error {
+	switch n.rep {
+	case parquetformat.FieldRepetitionType_OPTIONAL:
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Map) && v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+	case parquetformat.FieldRepetitionType_REPEATED:
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i)
+			if e.Kind() == reflect.Ptr {
+				if e.IsNil() {
+					return fmt.Errorf("parquet: nil element in repeated field %s", n.path())
+				}
+				e = e.Elem()
+			}
+			if err := sw.validateValue(n, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return sw.validateValue(n, v)
+}
+
+func (sw *StructWriter) validateValue(n *structNode, v reflect.Value) error {
+	switch {
+	case n.col != nil:
+		return nil
+	case n.isMap:
+		kv := n.children[0]
+		if len(kv.children) == 1 {
+			return nil
+		}
+		for _, k := range v.MapKeys() {
+			if err := sw.validate(kv.children[1], v.MapIndex(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, c := range n.children {
+		if err := sw.validate(c, v.Field(c.field)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeNode writes v (a value of a field described by n) starting with
+// repetition level r.
+func (sw *StructWriter) writeNode(n *structNode, v reflect.Value, r uint16) error {
+	switch n.rep {
+	case parquetformat.FieldRepetitionType_OPTIONAL:
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Map) && v.IsNil() {
+			n.writeNulls(n.d-1, r)
+			return nil
+		}
+		if v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+	case parquetformat.FieldRepetitionType_REPEATED:
+		if v.Len() == 0 {
+			n.writeNulls(n.d-1, r)
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i)
+			if e.Kind() == reflect.Ptr {
+				e = e.Elem()
+			}
+			if i > 0 {
+				r = n.r
+			}
+			if err := sw.writeValue(n, e, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return sw.writeValue(n, v, r)
+}
+
+// writeValue writes a non-null value v of n starting with repetition level r.
+func (sw *StructWriter) writeValue(n *structNode, v reflect.Value, r uint16) error {
+	switch {
+	case n.col != nil:
+		n.col.add(v, n.d, r)
+		return nil
+
+	case n.isMap:
+		kv := n.children[0]
+		if v.Len() == 0 {
+			kv.writeNulls(n.d, r)
+			return nil
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return lessValue(keys[i], keys[j]) })
+		for i, k := range keys {
+			if i > 0 {
+				r = kv.r
+			}
+			kv.children[0].col.add(k, kv.d, r)
+			if len(kv.children) == 2 {
+				if err := sw.writeNode(kv.children[1], v.MapIndex(k), r); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, c := range n.children {
+		if err := sw.writeNode(c, v.Field(c.field), r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lessValue compares map keys.
+func lessValue(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if x, y := a.Index(i).Uint(), b.Index(i).Uint(); x != y {
+				return x < y
+			}
+		}
+		return false
+	case reflect.Struct:
+		if a.Type() == timeType {
+			return a.Interface().(time.Time).Before(b.Interface().(time.Time))
+		}
+	}
+	return false
+}
+
+// structNode describes a schema element created for a Go type.
+type structNode struct {
+	name   string
+	field  int // index of the struct field or -1
+	parent *structNode
+
+	rep  parquetformat.FieldRepetitionType
+	d    uint16 // the definition level when the element is defined
+	r    uint16 // the repetition level of the element
+	elem *parquetformat.SchemaElement
+
+	children []*structNode
+	isMap    bool // children[0] is a repeated group with key and value
+
+	// leaf fields
+	col      *structColumnWriter
+	goType   reflect.Type
+	encoding *parquetformat.Encoding
+	codec    *parquetformat.CompressionCodec
+}
+
+func (n *structNode) path() string {
+	if n.parent == nil || n.parent.parent == nil {
+		return n.name
+	}
+	return n.parent.path() + "." + n.name
+}
+
+func (n *structNode) newChild(name string, field int, t reflect.Type) (*structNode, reflect.Type, error) {
+	c := &structNode{
+		name:   name,
+		field:  field,
+		parent: n,
+		rep:    parquetformat.FieldRepetitionType_REQUIRED,
+		d:      n.d,
+		r:      n.r,
+	}
+	switch {
+	case t.Kind() == reflect.Ptr:
+		c.rep = parquetformat.FieldRepetitionType_OPTIONAL
+		t = t.Elem()
+	case t.Kind() == reflect.Map:
+		c.rep = parquetformat.FieldRepetitionType_OPTIONAL
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		c.rep = parquetformat.FieldRepetitionType_REPEATED
+		t = t.Elem()
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	switch {
+	case t.Kind() == reflect.Ptr:
+		return nil, nil, fmt.Errorf("parquet: unsupported type of field %s: %s", c.path(), t)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		return nil, nil, fmt.Errorf("parquet: unsupported type of field %s: %s (nested slices are not supported)",
+			c.path(), t)
+	}
+	switch c.rep {
+	case parquetformat.FieldRepetitionType_OPTIONAL:
+		c.d++
+	case parquetformat.FieldRepetitionType_REPEATED:
+		c.d++
+		c.r++
+	}
+	return c, t, nil
+}
+
+// createGroup creates children of n for fields of struct t.
+func (n *structNode) createGroup(t reflect.Type, opts []string) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name, fopts := parseStructTag(f.Tag.Get("parquet"))
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		c, ft, err := n.newChild(name, i, f.Type)
+		if err != nil {
+			return err
+		}
+		if err = c.create(ft, append(opts[:len(opts):len(opts)], fopts...)); err != nil {
+			return err
+		}
+		n.children = append(n.children, c)
+	}
+	if len(n.children) == 0 {
+		return fmt.Errorf("parquet: %s has no fields to write", t)
+	}
+	n.elem = &parquetformat.SchemaElement{Name: n.name}
+	return nil
+}
+
+// create initializes n for Go type t (without pointers and slices of a
+// repeated field). opts are options from struct tags.
+func (n *structNode) create(t reflect.Type, opts []string) error {
+	switch {
+	case t == timeType || t == int96Type:
+		return n.createLeaf(t, opts)
+
+	case t.Kind() == reflect.Struct || t.Kind() == reflect.Map:
+		for _, opt := range opts {
+			if strings.HasPrefix(opt, "type=") || strings.HasPrefix(opt, "logical=") {
+				return fmt.Errorf("parquet: type option cannot be used for group %s", n.path())
+			}
+		}
+	}
+
+	switch {
+
+	case t.Kind() == reflect.Struct:
+		return n.createGroup(t, opts)
+
+	case t.Kind() == reflect.Map:
+		n.isMap = true
+		n.elem = &parquetformat.SchemaElement{
+			Name:          n.name,
+			ConvertedType: parquetformat.ConvertedTypePtr(parquetformat.ConvertedType_MAP),
+			LogicalType:   &parquetformat.LogicalType{MAP: &parquetformat.MapType{}},
+		}
+		kv := &structNode{
+			name:   "key_value",
+			field:  -1,
+			parent: n,
+			rep:    parquetformat.FieldRepetitionType_REPEATED,
+			d:      n.d + 1,
+			r:      n.r + 1,
+		}
+		kv.elem = &parquetformat.SchemaElement{Name: kv.name}
+		n.children = []*structNode{kv}
+
+		key := &structNode{
+			name:   "key",
+			field:  -1,
+			parent: kv,
+			rep:    parquetformat.FieldRepetitionType_REQUIRED,
+			d:      kv.d,
+			r:      kv.r,
+		}
+		if err := key.createLeaf(t.Key(), opts); err != nil {
+			return err
+		}
+		value, vt, err := kv.newChild("value", -1, t.Elem())
+		if err != nil {
+			return err
+		}
+		if err = value.create(vt, opts); err != nil {
+			return err
+		}
+		kv.children = []*structNode{key, value}
+		return nil
+	}
+	return n.createLeaf(t, opts)
+}
+
+// createLeaf initializes a primitive field n for Go type t.
+func (n *structNode) createLeaf(t reflect.Type, opts []string) error {
+	n.goType = t
+	var (
+		typ    parquetformat.Type
+		length int32
+		ct     = parquetformat.ConvertedType(-1)
+		lt     *parquetformat.LogicalType
+		// the logical option as specified in the struct tag
+		logical string
+		typeOpt bool
+	)
+	switch {
+	case t == timeType:
+		typ = parquetformat.Type_INT64
+		ct = parquetformat.ConvertedType_TIMESTAMP_MILLIS
+	case t == int96Type:
+		typ = parquetformat.Type_INT96
+	default:
+		switch t.Kind() {
+		case reflect.Bool:
+			typ = parquetformat.Type_BOOLEAN
+		case reflect.Int8:
+			typ = parquetformat.Type_INT32
+			ct = parquetformat.ConvertedType_INT_8
+		case reflect.Int16:
+			typ = parquetformat.Type_INT32
+			ct = parquetformat.ConvertedType_INT_16
+		case reflect.Int32:
+			typ = parquetformat.Type_INT32
+		case reflect.Uint8:
+			typ = parquetformat.Type_INT32
+			ct = parquetformat.ConvertedType_UINT_8
+		case reflect.Uint16:
+			typ = parquetformat.Type_INT32
+			ct = parquetformat.ConvertedType_UINT_16
+		case reflect.Uint32:
+			typ = parquetformat.Type_INT32
+			ct = parquetformat.ConvertedType_UINT_32
+		case reflect.Int, reflect.Int64:
+			typ = parquetformat.Type_INT64
+		case reflect.Uint, reflect.Uint64:
+			typ = parquetformat.Type_INT64
+			ct = parquetformat.ConvertedType_UINT_64
+		case reflect.Float32:
+			typ = parquetformat.Type_FLOAT
+		case reflect.Float64:
+			typ = parquetformat.Type_DOUBLE
+		case reflect.String:
+			typ = parquetformat.Type_BYTE_ARRAY
+			ct = parquetformat.ConvertedType_UTF8
+		case reflect.Slice:
+			// only []byte, other slices are repeated fields
+			typ = parquetformat.Type_BYTE_ARRAY
+		case reflect.Array:
+			if t.Elem().Kind() == reflect.Uint8 && t.Len() > 0 {
+				typ = parquetformat.Type_FIXED_LEN_BYTE_ARRAY
+				length = int32(t.Len())
+				break
+			}
+			fallthrough
+		default:
+			return fmt.Errorf("parquet: unsupported type of field %s: %s", n.path(), t)
+		}
+	}
+
+	for _, opt := range opts {
+		var err error
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("parquet: invalid option of field %s: %q", n.path(), opt)
+		}
+		value := strings.ToUpper(kv[1])
+		switch kv[0] {
+		case "type":
+			if value == "NONE" {
+				ct = -1
+			} else if ct, err = parquetformat.ConvertedTypeFromString(value); err != nil {
+				return fmt.Errorf("parquet: invalid type of field %s: %s", n.path(), kv[1])
+			}
+			typeOpt = true
+		case "logical":
+			if lt, err = parseLogicalType(value); err != nil {
+				return fmt.Errorf("parquet: invalid logical type of field %s: %s: %s", n.path(), kv[1], err)
+			}
+			ct = logicalToConvertedType(lt)
+			logical = kv[1]
+		case "encoding":
+			e, err := parquetformat.EncodingFromString(value)
+			if err != nil {
+				return fmt.Errorf("parquet: invalid encoding of field %s: %s", n.path(), kv[1])
+			}
+			n.encoding = &e
+		case "compression":
+			c, err := parquetformat.CompressionCodecFromString(value)
+			if err != nil {
+				return fmt.Errorf("parquet: invalid compression of field %s: %s", n.path(), kv[1])
+			}
+			if err = checkCompressionCodec(c); err != nil {
+				return fmt.Errorf("parquet: field %s: %s", n.path(), err)
+			}
+			n.codec = &c
+		default:
+			return fmt.Errorf("parquet: invalid option of field %s: %q", n.path(), opt)
+		}
+	}
+
+	if typeOpt && logical != "" {
+		return fmt.Errorf("parquet: type and logical options cannot be used together for field %s", n.path())
+	}
+	if lt == nil && ct != -1 {
+		lt = convertedToLogicalType(ct)
+	}
+
+	if t == timeType {
+		switch {
+		case lt != nil && lt.DATE != nil:
+			typ = parquetformat.Type_INT32
+		case lt != nil && lt.TIMESTAMP != nil:
+		case logical != "":
+			return fmt.Errorf("parquet: unsupported logical type of time.Time field %s: %s", n.path(), logical)
+		default:
+			return fmt.Errorf("parquet: unsupported type of time.Time field %s: %s", n.path(), ct)
+		}
+	} else if logical != "" {
+		if !logicalTypeAllowed(typ, length, lt) {
+			return fmt.Errorf("parquet: %s field %s cannot be annotated with %s", typ, n.path(), logical)
+		}
+	} else if ct != -1 && !convertedTypeAllowed(typ, ct) {
+		return fmt.Errorf("parquet: %s field %s cannot be annotated with %s", typ, n.path(), ct)
+	}
+
+	n.elem = &parquetformat.SchemaElement{
+		Name: n.name,
+		Type: &typ,
+	}
+	if length > 0 {
+		n.elem.TypeLength = &length
+	}
+	if ct != -1 {
+		n.elem.ConvertedType = &ct
+	}
+	n.elem.LogicalType = lt
+	if lt != nil && lt.DECIMAL != nil {
+		precision, scale := lt.DECIMAL.Precision, lt.DECIMAL.Scale
+		n.elem.Precision = &precision
+		n.elem.Scale = &scale
+	}
+	return nil
+}
+
+// convertedTypeAllowed returns true if values of typ can be annotated with ct.
+func convertedTypeAllowed(typ parquetformat.Type, ct parquetformat.ConvertedType) bool {
+	switch typ {
+	case parquetformat.Type_INT32:
+		switch ct {
+		case parquetformat.ConvertedType_INT_8, parquetformat.ConvertedType_INT_16,
+			parquetformat.ConvertedType_INT_32, parquetformat.ConvertedType_UINT_8,
+			parquetformat.ConvertedType_UINT_16, parquetformat.ConvertedType_UINT_32,
+			parquetformat.ConvertedType_DATE, parquetformat.ConvertedType_TIME_MILLIS:
+			return true
+		}
+	case parquetformat.Type_INT64:
+		switch ct {
+		case parquetformat.ConvertedType_INT_64, parquetformat.ConvertedType_UINT_64,
+			parquetformat.ConvertedType_TIMESTAMP_MILLIS, parquetformat.ConvertedType_TIMESTAMP_MICROS,
+			parquetformat.ConvertedType_TIME_MICROS:
+			return true
+		}
+	case parquetformat.Type_BYTE_ARRAY:
+		switch ct {
+		case parquetformat.ConvertedType_UTF8, parquetformat.ConvertedType_ENUM,
+			parquetformat.ConvertedType_JSON, parquetformat.ConvertedType_BSON:
+			return true
+		}
+	case parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		return ct == parquetformat.ConvertedType_INTERVAL
+	}
+	return false
+}
+
+// parseLogicalType parses the value of a logical struct tag option, e.g.
+// TIMESTAMP(MICROS,false) or DECIMAL(10,2).
+func parseLogicalType(s string) (*parquetformat.LogicalType, error) {
+	name, args := s, []string(nil)
+	if i := strings.IndexByte(s, '('); i >= 0 {
+		if !strings.HasSuffix(s, ")") {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		name = s[:i]
+		for _, arg := range strings.Split(s[i+1:len(s)-1], ",") {
+			args = append(args, strings.TrimSpace(arg))
+		}
+	}
+
+	lt := &parquetformat.LogicalType{}
+	switch name {
+	case "STRING":
+		lt.STRING = &parquetformat.StringType{}
+	case "ENUM":
+		lt.ENUM = &parquetformat.EnumType{}
+	case "JSON":
+		lt.JSON = &parquetformat.JsonType{}
+	case "BSON":
+		lt.BSON = &parquetformat.BsonType{}
+	case "DATE":
+		lt.DATE = &parquetformat.DateType{}
+	case "UUID":
+		lt.UUID = &parquetformat.UUIDType{}
+	case "INT":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("bit width and signedness expected")
+		}
+		bits, err := strconv.Atoi(args[0])
+		if err != nil || (bits != 8 && bits != 16 && bits != 32 && bits != 64) {
+			return nil, fmt.Errorf("invalid bit width %s", args[0])
+		}
+		signed, err := strconv.ParseBool(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid signedness %s", args[1])
+		}
+		lt.INTEGER = &parquetformat.IntType{BitWidth: int8(bits), IsSigned: signed}
+		return lt, nil
+	case "TIME", "TIMESTAMP":
+		if len(args) != 1 && len(args) != 2 {
+			return nil, fmt.Errorf("time unit expected")
+		}
+		unit := &parquetformat.TimeUnit{}
+		switch args[0] {
+		case "MILLIS":
+			unit.MILLIS = &parquetformat.MilliSeconds{}
+		case "MICROS":
+			unit.MICROS = &parquetformat.MicroSeconds{}
+		default:
+			return nil, fmt.Errorf("invalid time unit %s", args[0])
+		}
+		utc := true
+		if len(args) == 2 {
+			var err error
+			if utc, err = strconv.ParseBool(args[1]); err != nil {
+				return nil, fmt.Errorf("invalid UTC adjustment %s", args[1])
+			}
+		}
+		if name == "TIME" {
+			lt.TIME = &parquetformat.TimeType{IsAdjustedToUTC: utc, Unit: unit}
+		} else {
+			lt.TIMESTAMP = &parquetformat.TimestampType{IsAdjustedToUTC: utc, Unit: unit}
+		}
+		return lt, nil
+	case "DECIMAL":
+		if len(args) != 1 && len(args) != 2 {
+			return nil, fmt.Errorf("precision expected")
+		}
+		precision, err := strconv.Atoi(args[0])
+		if err != nil || precision < 1 || precision > math.MaxInt32 {
+			return nil, fmt.Errorf("invalid precision %s", args[0])
+		}
+		scale := 0
+		if len(args) == 2 {
+			if scale, err = strconv.Atoi(args[1]); err != nil || scale < 0 || scale > precision {
+				return nil, fmt.Errorf("invalid scale %s", args[1])
+			}
+		}
+		lt.DECIMAL = &parquetformat.DecimalType{Precision: int32(precision), Scale: int32(scale)}
+		return lt, nil
+	default:
+		return nil, fmt.Errorf("unknown logical type")
+	}
+	if args != nil {
+		return nil, fmt.Errorf("unexpected parameters")
+	}
+	return lt, nil
+}
+
+// logicalTypeAllowed returns true if values of typ (with the given length for
+// FIXED_LEN_BYTE_ARRAY) can be annotated with lt.
+func logicalTypeAllowed(typ parquetformat.Type, length int32, lt *parquetformat.LogicalType) bool {
+	switch {
+	case lt.STRING != nil, lt.ENUM != nil, lt.JSON != nil, lt.BSON != nil:
+		return typ == parquetformat.Type_BYTE_ARRAY
+	case lt.DATE != nil:
+		return typ == parquetformat.Type_INT32
+	case lt.UUID != nil:
+		return typ == parquetformat.Type_FIXED_LEN_BYTE_ARRAY && length == 16
+	case lt.INTEGER != nil:
+		if lt.INTEGER.BitWidth == 64 {
+			return typ == parquetformat.Type_INT64
+		}
+		return typ == parquetformat.Type_INT32
+	case lt.TIME != nil:
+		if lt.TIME.Unit.MILLIS != nil {
+			return typ == parquetformat.Type_INT32
+		}
+		return typ == parquetformat.Type_INT64
+	case lt.TIMESTAMP != nil:
+		return typ == parquetformat.Type_INT64
+	case lt.DECIMAL != nil:
+		p := lt.DECIMAL.Precision
+		switch typ {
+		case parquetformat.Type_INT32:
+			return p <= 9
+		case parquetformat.Type_INT64:
+			return p <= 18
+		case parquetformat.Type_BYTE_ARRAY:
+			return true
+		case parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+			// the number of base-10 digits that fit into a signed
+			// length-byte integer
+			return float64(p) <= math.Floor(float64(8*length-1)*math.Log10(2))
+		}
+	}
+	return false
+}
+
+// logicalToConvertedType returns the converted type equivalent to lt or -1 if
+// there is no such converted type.
+func logicalToConvertedType(lt *parquetformat.LogicalType) parquetformat.ConvertedType {
+	switch {
+	case lt.STRING != nil:
+		return parquetformat.ConvertedType_UTF8
+	case lt.ENUM != nil:
+		return parquetformat.ConvertedType_ENUM
+	case lt.JSON != nil:
+		return parquetformat.ConvertedType_JSON
+	case lt.BSON != nil:
+		return parquetformat.ConvertedType_BSON
+	case lt.DATE != nil:
+		return parquetformat.ConvertedType_DATE
+	case lt.DECIMAL != nil:
+		return parquetformat.ConvertedType_DECIMAL
+	case lt.INTEGER != nil:
+		for _, ct := range []parquetformat.ConvertedType{
+			parquetformat.ConvertedType_INT_8, parquetformat.ConvertedType_INT_16,
+			parquetformat.ConvertedType_INT_32, parquetformat.ConvertedType_INT_64,
+			parquetformat.ConvertedType_UINT_8, parquetformat.ConvertedType_UINT_16,
+			parquetformat.ConvertedType_UINT_32, parquetformat.ConvertedType_UINT_64,
+		} {
+			if *convertedToLogicalType(ct).INTEGER == *lt.INTEGER {
+				return ct
+			}
+		}
+	case lt.TIME != nil && lt.TIME.IsAdjustedToUTC:
+		// converted types are always adjusted to UTC
+		if lt.TIME.Unit.MILLIS != nil {
+			return parquetformat.ConvertedType_TIME_MILLIS
+		}
+		return parquetformat.ConvertedType_TIME_MICROS
+	case lt.TIMESTAMP != nil && lt.TIMESTAMP.IsAdjustedToUTC:
+		if lt.TIMESTAMP.Unit.MILLIS != nil {
+			return parquetformat.ConvertedType_TIMESTAMP_MILLIS
+		}
+		return parquetformat.ConvertedType_TIMESTAMP_MICROS
+	}
+	return -1
+}
+
+// convertedToLogicalType returns the logical type equivalent to ct or nil if
+// there is no such logical type.
+func convertedToLogicalType(ct parquetformat.ConvertedType) *parquetformat.LogicalType {
+	millis := &parquetformat.TimeUnit{MILLIS: &parquetformat.MilliSeconds{}}
+	micros := &parquetformat.TimeUnit{MICROS: &parquetformat.MicroSeconds{}}
+	integer := func(bits int8, signed bool) *parquetformat.LogicalType {
+		return &parquetformat.LogicalType{INTEGER: &parquetformat.IntType{BitWidth: bits, IsSigned: signed}}
+	}
+	switch ct {
+	case parquetformat.ConvertedType_UTF8:
+		return &parquetformat.LogicalType{STRING: &parquetformat.StringType{}}
+	case parquetformat.ConvertedType_ENUM:
+		return &parquetformat.LogicalType{ENUM: &parquetformat.EnumType{}}
+	case parquetformat.ConvertedType_JSON:
+		return &parquetformat.LogicalType{JSON: &parquetformat.JsonType{}}
+	case parquetformat.ConvertedType_BSON:
+		return &parquetformat.LogicalType{BSON: &parquetformat.BsonType{}}
+	case parquetformat.ConvertedType_DATE:
+		return &parquetformat.LogicalType{DATE: &parquetformat.DateType{}}
+	case parquetformat.ConvertedType_TIME_MILLIS:
+		return &parquetformat.LogicalType{TIME: &parquetformat.TimeType{IsAdjustedToUTC: true, Unit: millis}}
+	case parquetformat.ConvertedType_TIME_MICROS:
+		return &parquetformat.LogicalType{TIME: &parquetformat.TimeType{IsAdjustedToUTC: true, Unit: micros}}
+	case parquetformat.ConvertedType_TIMESTAMP_MILLIS:
+		return &parquetformat.LogicalType{TIMESTAMP: &parquetformat.TimestampType{IsAdjustedToUTC: true, Unit: millis}}
+	case parquetformat.ConvertedType_TIMESTAMP_MICROS:
+		return &parquetformat.LogicalType{TIMESTAMP: &parquetformat.TimestampType{IsAdjustedToUTC: true, Unit: micros}}
+	case parquetformat.ConvertedType_INT_8:
+		return integer(8, true)
+	case parquetformat.ConvertedType_INT_16:
+		return integer(16, true)
+	case parquetformat.ConvertedType_INT_32:
+		return integer(32, true)
+	case parquetformat.ConvertedType_INT_64:
+		return integer(64, true)
+	case parquetformat.ConvertedType_UINT_8:
+		return integer(8, false)
+	case parquetformat.ConvertedType_UINT_16:
+		return integer(16, false)
+	case parquetformat.ConvertedType_UINT_32:
+		return integer(32, false)
+	case parquetformat.ConvertedType_UINT_64:
+		return integer(64, false)
+	}
+	return nil
+}
+
+// schemaElements appends SchemaElements of n and all its descendants to
+// elems.
+func (n *structNode) schemaElements(elems []*parquetformat.SchemaElement) []*parquetformat.SchemaElement {
+	rep := n.rep
+	n.elem.RepetitionType = &rep
+	if len(n.children) > 0 {
+		numChildren := int32(len(n.children))
+		n.elem.NumChildren = &numChildren
+	}
+	elems = append(elems, n.elem)
+	for _, c := range n.children {
+		elems = c.schemaElements(elems)
+	}
+	return elems
+}
+
+// collectColumns creates writers for all primitive fields under n.
+func (n *structNode) collectColumns(schema Schema, path []string, cols []*structColumnWriter) []*structColumnWriter {
+	if n.parent != nil {
+		path = append(path[:len(path):len(path)], n.name)
+	}
+	if n.children == nil {
+		col, ok := schema.ColumnByPath(path)
+		if !ok {
+			panic("column not found: " + strings.Join(path, "."))
+		}
+		n.col = newStructColumnWriter(col, n.goType)
+		n.col.encoding = n.encoding
+		n.col.codec = n.codec
+		return append(cols, n.col)
+	}
+	for _, c := range n.children {
+		cols = c.collectColumns(schema, path, cols)
+	}
+	return cols
+}
+
+// writeNulls writes null values with definition level d and repetition level
+// r to all columns under n.
+func (n *structNode) writeNulls(d uint16, r uint16) {
+	if n.col != nil {
+		n.col.addNull(d, r)
+		return
+	}
+	for _, c := range n.children {
+		c.writeNulls(d, r)
+	}
+}
+
+// structColumnWriter buffers values of a single column.
+type structColumnWriter struct {
+	col      Column
+	cw       *ColumnChunkWriter
+	encoding *parquetformat.Encoding
+	codec    *parquetformat.CompressionCodec
+
+	dLevels []uint16
+	rLevels []uint16
+
+	bools      []bool
+	int32s     []int32
+	int64s     []int64
+	int96s     []Int96
+	float32s   []float32
+	float64s   []float64
+	byteArrays [][]byte
+
+	appendValue func(v reflect.Value)
+	valueSize   int   // size of a single value or 0 for byte arrays
+	size        int64 // approximate size of the buffered values
+}
+
+func newStructColumnWriter(col Column, t reflect.Type) *structColumnWriter {
+	c := &structColumnWriter{col: col}
+	switch col.Type() {
+	case parquetformat.Type_BOOLEAN, parquetformat.Type_INT32, parquetformat.Type_FLOAT:
+		c.valueSize = 4
+	case parquetformat.Type_INT64, parquetformat.Type_DOUBLE:
+		c.valueSize = 8
+	case parquetformat.Type_INT96:
+		c.valueSize = 12
+	}
+	switch col.Type() {
+	case parquetformat.Type_BOOLEAN:
+		c.appendValue = func(v reflect.Value) { c.bools = append(c.bools, v.Bool()) }
+	case parquetformat.Type_INT32:
+		switch {
+		case t == timeType:
+			c.appendValue = func(v reflect.Value) {
+				y, m, d := v.Interface().(time.Time).Date()
+				days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+				c.int32s = append(c.int32s, int32(days))
+			}
+		case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64:
+			c.appendValue = func(v reflect.Value) { c.int32s = append(c.int32s, int32(v.Uint())) }
+		default:
+			c.appendValue = func(v reflect.Value) { c.int32s = append(c.int32s, int32(v.Int())) }
+		}
+	case parquetformat.Type_INT64:
+		switch {
+		case t == timeType:
+			unit, utc := timestampUnit(col)
+			c.appendValue = func(v reflect.Value) {
+				tm := v.Interface().(time.Time)
+				sec := tm.Unix()
+				if !utc {
+					// timestamps not adjusted to UTC store the wall clock
+					_, offset := tm.Zone()
+					sec += int64(offset)
+				}
+				c.int64s = append(c.int64s, sec*int64(time.Second/unit)+int64(tm.Nanosecond())/int64(unit))
+			}
+		case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64:
+			c.appendValue = func(v reflect.Value) { c.int64s = append(c.int64s, int64(v.Uint())) }
+		default:
+			c.appendValue = func(v reflect.Value) { c.int64s = append(c.int64s, v.Int()) }
+		}
+	case parquetformat.Type_INT96:
+		c.appendValue = func(v reflect.Value) { c.int96s = append(c.int96s, v.Interface().(Int96)) }
+	case parquetformat.Type_FLOAT:
+		c.appendValue = func(v reflect.Value) { c.float32s = append(c.float32s, float32(v.Float())) }
+	case parquetformat.Type_DOUBLE:
+		c.appendValue = func(v reflect.Value) { c.float64s = append(c.float64s, v.Float()) }
+	case parquetformat.Type_BYTE_ARRAY, parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		switch t.Kind() {
+		case reflect.String:
+			c.appendValue = func(v reflect.Value) { c.byteArrays = append(c.byteArrays, []byte(v.String())) }
+		case reflect.Slice:
+			c.appendValue = func(v reflect.Value) {
+				c.byteArrays = append(c.byteArrays, append([]byte{}, v.Bytes()...))
+			}
+		default:
+			c.appendValue = func(v reflect.Value) {
+				b := make([]byte, v.Len())
+				reflect.Copy(reflect.ValueOf(b), v)
+				c.byteArrays = append(c.byteArrays, b)
+			}
+		}
+	}
+	return c
+}
+
+func (c *structColumnWriter) add(v reflect.Value, d uint16, r uint16) {
+	c.appendValue(v)
+	if c.valueSize > 0 {
+		c.size += int64(c.valueSize)
+	} else {
+		c.size += 4 + int64(len(c.byteArrays[len(c.byteArrays)-1]))
+	}
+	c.dLevels = append(c.dLevels, d)
+	c.rLevels = append(c.rLevels, r)
+}
+
+func (c *structColumnWriter) addNull(d uint16, r uint16) {
+	c.dLevels = append(c.dLevels, d)
+	c.rLevels = append(c.rLevels, r)
+}
+
+func (c *structColumnWriter) values() interface{} {
+	switch c.col.Type() {
+	case parquetformat.Type_BOOLEAN:
+		return c.bools
+	case parquetformat.Type_INT32:
+		return c.int32s
+	case parquetformat.Type_INT64:
+		return c.int64s
+	case parquetformat.Type_INT96:
+		return c.int96s
+	case parquetformat.Type_FLOAT:
+		return c.float32s
+	case parquetformat.Type_DOUBLE:
+		return c.float64s
+	}
+	return c.byteArrays
+}
+
+// flush passes all buffered values to the column chunk writer.
+func (c *structColumnWriter) flush() error {
+	if len(c.dLevels) == 0 {
+		return nil
+	}
+	if err := c.cw.Write(c.values(), c.dLevels, c.rLevels); err != nil {
+		return err
+	}
+	c.dLevels = c.dLevels[:0]
+	c.rLevels = c.rLevels[:0]
+	c.bools = c.bools[:0]
+	c.int32s = c.int32s[:0]
+	c.int64s = c.int64s[:0]
+	c.int96s = c.int96s[:0]
+	c.float32s = c.float32s[:0]
+	c.float64s = c.float64s[:0]
+	for i := range c.byteArrays {
+		c.byteArrays[i] = nil
+	}
+	c.byteArrays = c.byteArrays[:0]
+	c.size = 0
+	return nil
+}
@@ -0,0 +1,367 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+type structWriterTestRecord struct {
+	ID       int64 `parquet:"id"`
+	Small    int8
+	Unsigned uint32
+	Flag     bool
+	Ratio    float32
+	Score    *float64
+	Name     string `parquet:"name,compression=SNAPPY"`
+	Raw      []byte
+	Hash     [4]byte
+	TS       time.Time `parquet:"ts,type=TIMESTAMP_MICROS"`
+	Day      time.Time `parquet:"day,type=DATE"`
+	Tags     []string
+	Address  *struct {
+		City  string
+		Lines []string
+	}
+	Items []struct {
+		Code  int32
+		Price *float64
+	}
+	Attrs   map[string]int64
+	Ignored int `parquet:"-"`
+}
+
+func float64P(v float64) *float64 { return &v }
+
+func structWriterTestRecords() []structWriterTestRecord {
+	ts := time.Date(2017, 3, 4, 5, 6, 7, 8000, time.UTC)
+	day := time.Date(2017, 3, 4, 0, 0, 0, 0, time.UTC)
+	recs := make([]structWriterTestRecord, 3)
+
+	recs[0].ID = 1
+	recs[0].Small = -8
+	recs[0].Unsigned = 1<<32 - 1
+	recs[0].Flag = true
+	recs[0].Ratio = 0.5
+	recs[0].Score = float64P(1.5)
+	recs[0].Name = "first"
+	recs[0].Raw = []byte{1, 2, 3}
+	recs[0].Hash = [4]byte{1, 2, 3, 4}
+	recs[0].TS = ts
+	recs[0].Day = day
+	recs[0].Tags = []string{"a", "b"}
+	recs[0].Address = &struct {
+		City  string
+		Lines []string
+	}{"London", []string{"line 1", "line 2"}}
+	recs[0].Items = []struct {
+		Code  int32
+		Price *float64
+	}{{1, float64P(10)}, {2, nil}}
+	recs[0].Attrs = map[string]int64{"y": 2, "x": 1}
+
+	recs[1].ID = 2
+	recs[1].Raw = []byte{}
+	recs[1].TS = ts.Add(time.Hour)
+	recs[1].Day = day.AddDate(0, 0, 1)
+	recs[1].Address = &struct {
+		City  string
+		Lines []string
+	}{City: "Paris"}
+	recs[1].Attrs = map[string]int64{}
+
+	recs[2].ID = 3
+	recs[2].Raw = []byte{}
+	recs[2].TS = time.Unix(0, 0).UTC()
+	recs[2].Day = time.Unix(0, 0).UTC()
+	recs[2].Tags = []string{"c"}
+	return recs
+}
+
+func TestStructWriterRoundTrip(t *testing.T) {
+	recs := structWriterTestRecords()
+
+	buf := new(bytes.Buffer)
+	sw, err := NewStructWriter(buf, structWriterTestRecord{}, WithCompression(pf.CompressionCodec_GZIP))
+	if err != nil {
+		t.Fatalf("failed to create struct writer: %s", err)
+	}
+	for i := range recs {
+		if err = sw.Write(&recs[i]); err != nil {
+			t.Fatalf("failed to write record %d: %s", i, err)
+		}
+	}
+	if err = sw.Close(); err != nil {
+		t.Fatalf("failed to close struct writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	if len(f.MetaData.RowGroups) != 1 || f.MetaData.NumRows != 3 {
+		t.Errorf("got %d row groups with %d rows, want 1 row group with 3 rows",
+			len(f.MetaData.RowGroups), f.MetaData.NumRows)
+	}
+
+	var got []structWriterTestRecord
+	if err = Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if len(got) != len(recs) {
+		t.Fatalf("got %d records, want %d", len(got), len(recs))
+	}
+	for i := range recs {
+		g, w := reflect.ValueOf(got[i]), reflect.ValueOf(recs[i])
+		for j := 0; j < g.NumField(); j++ {
+			if !reflect.DeepEqual(g.Field(j).Interface(), w.Field(j).Interface()) {
+				t.Errorf("record %d, field %s: got %#v, want %#v", i, g.Type().Field(j).Name,
+					g.Field(j).Interface(), w.Field(j).Interface())
+			}
+		}
+	}
+
+	for _, col := range f.Schema.Columns() {
+		codec := f.MetaData.RowGroups[0].Columns[col.Index()].MetaData.Codec
+		want := pf.CompressionCodec_GZIP
+		if col.String() == "name" {
+			want = pf.CompressionCodec_SNAPPY
+		}
+		if codec != want {
+			t.Errorf("column %s: got codec %s, want %s", col, codec, want)
+		}
+	}
+}
+
+func TestStructWriterSchema(t *testing.T) {
+	sw, err := NewStructWriter(new(bytes.Buffer), &structWriterTestRecord{})
+	if err != nil {
+		t.Fatalf("failed to create struct writer: %s", err)
+	}
+	want := `message structWriterTestRecord {
+  required int64 id;
+  required int32 Small (INT_8);
+  required int32 Unsigned (UINT_32);
+  required boolean Flag;
+  required float Ratio;
+  optional double Score;
+  required byte_array name (UTF8);
+  required byte_array Raw;
+  required fixed_len_byte_array(4) Hash;
+  required int64 ts (TIMESTAMP_MICROS);
+  required int32 day (DATE);
+  repeated byte_array Tags (UTF8);
+  optional group Address {
+    required byte_array City (UTF8);
+    repeated byte_array Lines (UTF8);
+  }
+  repeated group Items {
+    required int32 Code;
+    optional double Price;
+  }
+  optional group Attrs (MAP) {
+    repeated group key_value {
+      required byte_array key (UTF8);
+      required int64 value;
+    }
+  }
+}`
+	if got := sw.Schema().DisplayString(); got != want {
+		t.Errorf("got schema\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestStructWriterLogicalTypes(t *testing.T) {
+	type rec struct {
+		Name   string
+		Small  uint8
+		Millis time.Time
+		Local  time.Time `parquet:"local,logical=TIMESTAMP(MICROS,false)"`
+		Price  int64     `parquet:"price,logical=DECIMAL(12,2)"`
+		ID     [16]byte  `parquet:"id,logical=UUID"`
+	}
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	in := rec{
+		Name:   "a",
+		Small:  200,
+		Millis: time.Date(2017, 3, 4, 5, 6, 7, 8000000, time.UTC),
+		Local:  time.Date(2017, 3, 4, 5, 6, 7, 8000, loc),
+		Price:  12345,
+		ID:     [16]byte{1, 2, 3},
+	}
+
+	buf := new(bytes.Buffer)
+	sw, err := NewStructWriter(buf, rec{})
+	if err != nil {
+		t.Fatalf("failed to create struct writer: %s", err)
+	}
+	if err = sw.Write(in); err != nil {
+		t.Fatalf("failed to write record: %s", err)
+	}
+	if err = sw.Close(); err != nil {
+		t.Fatalf("failed to close struct writer: %s", err)
+	}
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+
+	millis := &pf.TimeUnit{MILLIS: &pf.MilliSeconds{}}
+	micros := &pf.TimeUnit{MICROS: &pf.MicroSeconds{}}
+	tests := []struct {
+		ct               *pf.ConvertedType
+		lt               *pf.LogicalType
+		precision, scale *int32
+	}{
+		{ctUTF8, &pf.LogicalType{STRING: &pf.StringType{}}, nil, nil},
+		{pf.ConvertedTypePtr(pf.ConvertedType_UINT_8), &pf.LogicalType{INTEGER: &pf.IntType{BitWidth: 8}}, nil, nil},
+		{
+			pf.ConvertedTypePtr(pf.ConvertedType_TIMESTAMP_MILLIS),
+			&pf.LogicalType{TIMESTAMP: &pf.TimestampType{IsAdjustedToUTC: true, Unit: millis}}, nil, nil,
+		},
+		// converted types are always adjusted to UTC
+		{nil, &pf.LogicalType{TIMESTAMP: &pf.TimestampType{Unit: micros}}, nil, nil},
+		{pf.ConvertedTypePtr(pf.ConvertedType_DECIMAL), &pf.LogicalType{DECIMAL: &pf.DecimalType{Precision: 12, Scale: 2}}, int32Ptr(12), int32Ptr(2)},
+		{nil, &pf.LogicalType{UUID: &pf.UUIDType{}}, nil, nil},
+	}
+	for _, col := range f.Schema.Columns() {
+		test, se := tests[col.Index()], col.schemaElement
+		if !reflect.DeepEqual(se.ConvertedType, test.ct) || !reflect.DeepEqual(se.LogicalType, test.lt) ||
+			!reflect.DeepEqual(se.Precision, test.precision) || !reflect.DeepEqual(se.Scale, test.scale) {
+			t.Errorf("column %s: got converted type %v, logical type %v, precision %v, scale %v, want %v, %v, %v, %v",
+				col, se.ConvertedType, se.LogicalType, se.Precision, se.Scale, test.ct, test.lt, test.precision, test.scale)
+		}
+	}
+
+	var got []rec
+	if err = Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	want := in
+	// the wall clock of timestamps not adjusted to UTC is preserved
+	want.Local = time.Date(2017, 3, 4, 5, 6, 7, 8000, time.UTC)
+	if len(got) != 1 || !reflect.DeepEqual(got[0], want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestStructWriterRowGroups(t *testing.T) {
+	type rec struct {
+		A int64
+		B string
+	}
+	buf := new(bytes.Buffer)
+	sw, err := NewStructWriter(buf, rec{}, WithRowGroupSize(1000), WithPageRowCount(10))
+	if err != nil {
+		t.Fatalf("failed to create struct writer: %s", err)
+	}
+	var want []rec
+	for i := 0; i < 1000; i++ {
+		r := rec{int64(i), strings.Repeat("x", i%10)}
+		if err = sw.Write(r); err != nil {
+			t.Fatalf("failed to write record %d: %s", i, err)
+		}
+		want = append(want, r)
+	}
+	if err = sw.Close(); err != nil {
+		t.Fatalf("failed to close struct writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	if n := len(f.MetaData.RowGroups); n < 2 {
+		t.Errorf("got %d row groups, want more", n)
+	}
+	var got []rec
+	if err = Unmarshal(f, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("records read are not the same as written")
+	}
+}
+
+func TestStructWriterErrors(t *testing.T) {
+	tests := []struct {
+		proto interface{}
+		err   string
+	}{
+		{1, "not a struct"},
+		{struct{ a int }{}, "no fields"},
+		{struct{ A [][]int }{}, "nested slices"},
+		{struct{ A **int }{}, "unsupported type"},
+		{struct{ A chan int }{}, "unsupported type"},
+		{struct {
+			A string `parquet:",type=DATE"`
+		}{}, "cannot be annotated"},
+		{struct {
+			A string `parquet:",type=XXX"`
+		}{}, "invalid type"},
+		{struct {
+			A int32 `parquet:",compression=XXX"`
+		}{}, "invalid compression"},
+		{struct {
+			A int32 `parquet:",foo"`
+		}{}, "invalid option"},
+		{struct {
+			A struct{ B int } `parquet:",type=UTF8"`
+		}{}, "group A"},
+		{struct {
+			A [8]byte `parquet:",logical=UUID"`
+		}{}, "cannot be annotated"},
+		{struct {
+			A int32 `parquet:",logical=DECIMAL(10,2)"`
+		}{}, "cannot be annotated"},
+		{struct {
+			A int64 `parquet:",logical=DECIMAL(10,11)"`
+		}{}, "invalid logical type"},
+		{struct {
+			A int64 `parquet:",logical=TIMESTAMP(NANOS)"`
+		}{}, "invalid logical type"},
+		{struct {
+			A string `parquet:",logical=XXX"`
+		}{}, "invalid logical type"},
+		{struct {
+			A string `parquet:",type=UTF8,logical=STRING"`
+		}{}, "cannot be used together"},
+		{struct {
+			A time.Time `parquet:",logical=TIME(MILLIS)"`
+		}{}, "unsupported logical type"},
+	}
+	for _, test := range tests {
+		_, err := NewStructWriter(new(bytes.Buffer), test.proto)
+		if err == nil {
+			t.Errorf("%T: error expected", test.proto)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%T: got error %q, want error containing %q", test.proto, err, test.err)
+		}
+	}
+
+	type rec struct {
+		A []*int
+	}
+	sw, err := NewStructWriter(new(bytes.Buffer), rec{})
+	if err != nil {
+		t.Fatalf("failed to create struct writer: %s", err)
+	}
+	if err = sw.Write(1); err == nil {
+		t.Errorf("error expected writing a value of a wrong type")
+	}
+	if err = sw.Write(rec{[]*int{nil}}); err == nil {
+		t.Errorf("error expected writing a nil element of a repeated field")
+	}
+	if err = sw.Write(rec{}); err != nil {
+		t.Errorf("failed to write a valid record after an invalid one: %s", err)
+	}
+	if err = sw.Close(); err != nil {
+		t.Errorf("failed to close struct writer: %s", err)
+	}
+}
@@ -67,7 +67,7 @@ func (d *booleanRLEDecoder) init(data []byte) error {
 		return errors.New("boolean/rle: not enough data to read data length")
 	}
 	n := uint(binary.LittleEndian.Uint32(data[:4]))
-	if n < 1 || n > uint(len(data)-4) {
+	if n > uint(len(data)-4) {
 		return fmt.Errorf("boolean/rle: invalid data length")
 	}
 	d.rle = newRLEDecoder(1)
@@ -89,3 +89,95 @@ func (d *booleanRLEDecoder) decodeBool(dst []bool) error {
 	}
 	return nil
 }
+
+type booleanEncoder interface {
+	encodeBool(values []bool) error
+}
+
+func encodeBoolean(e booleanEncoder, values interface{}) error {
+	switch values := values.(type) {
+	case []bool:
+		return e.encodeBool(values)
+	case []interface{}:
+		b := make([]bool, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.(bool); !ok {
+				return fmt.Errorf("boolean: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeBool(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type booleanPlainEncoder struct {
+	data []byte
+	n    int
+}
+
+func (e *booleanPlainEncoder) encode(values interface{}) error {
+	return encodeBoolean(e, values)
+}
+
+func (e *booleanPlainEncoder) encodeBool(values []bool) error {
+	for _, v := range values {
+		i := e.n % 8
+		if i == 0 {
+			e.data = append(e.data, 0)
+		}
+		if v {
+			e.data[len(e.data)-1] |= 1 << uint(i)
+		}
+		e.n++
+	}
+	return nil
+}
+
+func (e *booleanPlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *booleanPlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	e.n = 0
+	return data
+}
+
+type booleanRLEEncoder struct {
+	rle  *rleEncoder
+	data []byte
+}
+
+func newBooleanRLEEncoder() *booleanRLEEncoder {
+	return &booleanRLEEncoder{rle: newRLEEncoder(1)}
+}
+
+func (e *booleanRLEEncoder) encode(values interface{}) error {
+	return encodeBoolean(e, values)
+}
+
+func (e *booleanRLEEncoder) encodeBool(values []bool) error {
+	for _, v := range values {
+		if v {
+			e.rle.encode(1)
+		} else {
+			e.rle.encode(0)
+		}
+	}
+	return nil
+}
+
+func (e *booleanRLEEncoder) size() int {
+	return 4 + e.rle.size()
+}
+
+func (e *booleanRLEEncoder) flush() []byte {
+	rle := e.rle.flush()
+	e.data = append(e.data[:0], 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(e.data, uint32(len(rle)))
+	e.data = append(e.data, rle...)
+	return e.data
+}
@@ -1,7 +1,11 @@
 package parquet
 
 import (
+	"bytes"
+	"reflect"
 	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
 )
 
 func TestBooleanPlainDecoder(t *testing.T) {
@@ -37,3 +41,74 @@ func TestBooleanRLEDecoder(t *testing.T) {
 		},
 	})
 }
+
+func TestBooleanRLEEncoder(t *testing.T) {
+	e := newBooleanRLEEncoder()
+	for _, values := range [][]bool{
+		{true, false, true, false, true},
+		{true, true, true, true, true, true, true, true, true, false},
+		nil,
+	} {
+		if err := e.encode(values); err != nil {
+			t.Fatalf("failed to encode %v: %s", values, err)
+		}
+		data := append([]byte{}, e.flush()...)
+		d := &booleanRLEDecoder{}
+		if err := d.init(data); err != nil {
+			t.Fatalf("%v: failed to init decoder: %s", values, err)
+		}
+		got := make([]bool, len(values))
+		if err := d.decode(got); err != nil {
+			t.Fatalf("%v: failed to decode: %s", values, err)
+		}
+		if !reflect.DeepEqual(got, append([]bool{}, values...)) {
+			t.Errorf("got %v, want %v", got, values)
+		}
+	}
+}
+
+func TestWriteBooleanRLE(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(1)},
+		&pf.SchemaElement{Name: "b", Type: typeBoolean, RepetitionType: frtOptional},
+	))
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 2
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	col := schema.Columns()[0]
+	cw, err := fw.NewWriter(col)
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	if err = cw.SetEncoding(pf.Encoding_RLE); err != nil {
+		t.Fatalf("failed to set encoding: %s", err)
+	}
+	want := []cell{{1, 0, true}, {1, 0, false}, {0, 0, nil}, {0, 0, nil}, {1, 0, true}}
+	if err = cw.Write([]bool{true, false, true}, []uint16{1, 1, 0, 0, 1}, make([]uint16, 5)); err != nil {
+		t.Fatalf("failed to write: %s", err)
+	}
+	if err = cw.Close(); err != nil {
+		t.Fatalf("failed to close column chunk writer: %s", err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	cells, err := readCells(f, col, 0)
+	if err != nil {
+		t.Fatalf("failed to read: %s", err)
+	}
+	if !reflect.DeepEqual(cells, want) {
+		t.Errorf("got %v, want %v", cells, want)
+	}
+}
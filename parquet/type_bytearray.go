@@ -3,6 +3,7 @@ package parquet
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 )
 
 type byteArrayDecoder interface {
@@ -62,6 +63,33 @@ func (d *byteArrayPlainDecoder) next() (value []byte, err error) {
 	return value, err
 }
 
+func (d *byteArrayPlainDecoder) skip(n int) error {
+	if d.length > 0 {
+		if len(d.data) < d.length*n {
+			return errNED
+		}
+		d.data = d.data[d.length*n:]
+		return nil
+	}
+	for i := 0; i < n; i++ {
+		if len(d.data) == 0 {
+			return errNED
+		}
+		if len(d.data) < 4 {
+			return errors.New("bytearray/plain: not enough data to read length")
+		}
+		size := int(int32(binary.LittleEndian.Uint32(d.data)))
+		if size < 0 {
+			return errors.New("bytearray/plain: negative length")
+		}
+		if len(d.data)-4 < size {
+			return errors.New("bytearray/plain: not enough data to read value")
+		}
+		d.data = d.data[4+size:]
+	}
+	return nil
+}
+
 func (d *byteArrayPlainDecoder) decode(dst interface{}) error {
 	return decodeByteArray(d, dst)
 }
@@ -201,6 +229,9 @@ func (d *byteArrayDeltaDecoder) decodeByteSlice(dst [][]byte) error {
 			return err
 		}
 		prefixLen := int(d.prefixLens[d.suffixDecoder.i-1])
+		if prefixLen < 0 || prefixLen > len(d.value) {
+			return errors.New("bytearray/delta: invalid prefix length")
+		}
 		value := make([]byte, 0, prefixLen+len(suffix))
 		value = append(value, d.value[:prefixLen]...)
 		value = append(value, suffix...)
@@ -209,3 +240,263 @@ func (d *byteArrayDeltaDecoder) decodeByteSlice(dst [][]byte) error {
 	}
 	return nil
 }
+
+type byteArrayEncoder interface {
+	encodeByteSlice(values [][]byte) error
+}
+
+func encodeByteArray(e byteArrayEncoder, values interface{}) error {
+	switch values := values.(type) {
+	case [][]byte:
+		return e.encodeByteSlice(values)
+	case []interface{}:
+		b := make([][]byte, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.([]byte); !ok {
+				return fmt.Errorf("bytearray: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeByteSlice(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type byteArrayPlainEncoder struct {
+	// length > 0 for FIXED_BYTE_ARRAY type
+	length int
+
+	data []byte
+}
+
+func (e *byteArrayPlainEncoder) encode(values interface{}) error {
+	return encodeByteArray(e, values)
+}
+
+func (e *byteArrayPlainEncoder) encodeByteSlice(values [][]byte) error {
+	var buf [4]byte
+	for _, v := range values {
+		if e.length == 0 {
+			binary.LittleEndian.PutUint32(buf[:], uint32(len(v)))
+			e.data = append(e.data, buf[:]...)
+		} else if len(v) != e.length {
+			return fmt.Errorf("bytearray/plain: invalid value length %d (must be %d)", len(v), e.length)
+		}
+		e.data = append(e.data, v...)
+	}
+	return nil
+}
+
+func (e *byteArrayPlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *byteArrayPlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	return data
+}
+
+// byteArrayByteStreamSplitDecoder decodes FIXED_LEN_BYTE_ARRAY values.
+type byteArrayByteStreamSplitDecoder struct {
+	byteStreamSplitDecoder
+}
+
+func newByteArrayByteStreamSplitDecoder(length int) *byteArrayByteStreamSplitDecoder {
+	return &byteArrayByteStreamSplitDecoder{byteStreamSplitDecoder{width: length}}
+}
+
+func (d *byteArrayByteStreamSplitDecoder) decode(dst interface{}) error {
+	return decodeByteArray(d, dst)
+}
+
+func (d *byteArrayByteStreamSplitDecoder) decodeByteSlice(dst [][]byte) error {
+	for i := 0; i < len(dst); i++ {
+		if d.i >= d.n {
+			return errNED
+		}
+		value := make([]byte, d.width)
+		if err := d.next(value); err != nil {
+			return err
+		}
+		dst[i] = value
+	}
+	return nil
+}
+
+// byteArrayByteStreamSplitEncoder encodes FIXED_LEN_BYTE_ARRAY values.
+type byteArrayByteStreamSplitEncoder struct {
+	byteStreamSplitEncoder
+}
+
+func newByteArrayByteStreamSplitEncoder(length int) *byteArrayByteStreamSplitEncoder {
+	return &byteArrayByteStreamSplitEncoder{byteStreamSplitEncoder{width: length}}
+}
+
+func (e *byteArrayByteStreamSplitEncoder) encode(values interface{}) error {
+	return encodeByteArray(e, values)
+}
+
+func (e *byteArrayByteStreamSplitEncoder) encodeByteSlice(values [][]byte) error {
+	for _, v := range values {
+		if len(v) != e.width {
+			return fmt.Errorf("bytearray/byte_stream_split: invalid value length %d (must be %d)", len(v), e.width)
+		}
+		e.values = append(e.values, v...)
+	}
+	return nil
+}
+
+type byteArrayDictEncoder struct {
+	dictEncoder
+
+	// length > 0 for FIXED_BYTE_ARRAY type
+	length int
+
+	index   map[string]int32
+	entries [][]byte
+}
+
+func newByteArrayDictEncoder(length int) *byteArrayDictEncoder {
+	return &byteArrayDictEncoder{
+		dictEncoder: dictEncoder{dict: &byteArrayPlainEncoder{length: length}},
+		length:      length,
+		index:       make(map[string]int32),
+	}
+}
+
+func (e *byteArrayDictEncoder) encode(values interface{}) error {
+	return encodeByteArray(e, values)
+}
+
+func (e *byteArrayDictEncoder) encodeByteSlice(values [][]byte) error {
+	n := len(e.entries)
+	for _, v := range values {
+		k, ok := e.index[string(v)]
+		if !ok {
+			if e.length > 0 && len(v) != e.length {
+				return fmt.Errorf("bytearray/dict: invalid value length %d (must be %d)", len(v), e.length)
+			}
+			k = int32(len(e.entries))
+			e.index[string(v)] = k
+			e.entries = append(e.entries, append([]byte{}, v...))
+		}
+		e.keys = append(e.keys, k)
+		if e.length == 0 {
+			e.raw += 4 + len(v)
+		} else {
+			e.raw += e.length
+		}
+	}
+	e.count = len(e.entries)
+	return e.dict.encode(e.entries[n:])
+}
+
+func (e *byteArrayDictEncoder) fallBackTo(enc valuesEncoder) error {
+	values := make([][]byte, len(e.keys))
+	for i, k := range e.keys {
+		values[i] = e.entries[k]
+	}
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return enc.encode(values)
+}
+
+// byteArrayDeltaLengthEncoder encodes values using DELTA_LENGTH_BYTE_ARRAY
+// encoding: DELTA_BINARY_PACKED encoded lengths of all values followed by
+// the concatenated values.
+type byteArrayDeltaLengthEncoder struct {
+	lensEncoder *int32DeltaBinaryPackedEncoder
+	values      []byte
+	data        []byte
+}
+
+func newByteArrayDeltaLengthEncoder(blockSize int, numMiniBlocks int) *byteArrayDeltaLengthEncoder {
+	return &byteArrayDeltaLengthEncoder{
+		lensEncoder: newInt32DeltaBinaryPackedEncoder(blockSize, numMiniBlocks),
+	}
+}
+
+func (e *byteArrayDeltaLengthEncoder) encode(values interface{}) error {
+	return encodeByteArray(e, values)
+}
+
+func (e *byteArrayDeltaLengthEncoder) encodeByteSlice(values [][]byte) error {
+	lens := make([]int32, len(values))
+	for i, v := range values {
+		lens[i] = int32(len(v))
+		e.values = append(e.values, v...)
+	}
+	return e.lensEncoder.encodeInt32(lens)
+}
+
+func (e *byteArrayDeltaLengthEncoder) size() int {
+	return e.lensEncoder.size() + len(e.values)
+}
+
+func (e *byteArrayDeltaLengthEncoder) flush() []byte {
+	e.data = append(e.data[:0], e.lensEncoder.flush()...)
+	e.data = append(e.data, e.values...)
+	e.values = e.values[:0]
+	return e.data
+}
+
+// byteArrayDeltaEncoder encodes values using DELTA_BYTE_ARRAY encoding
+// (incremental or front coding): DELTA_BINARY_PACKED encoded lengths of
+// prefixes shared with the previous values followed by DELTA_LENGTH_BYTE_ARRAY
+// encoded suffixes.
+type byteArrayDeltaEncoder struct {
+	// length > 0 for FIXED_BYTE_ARRAY type
+	length int
+
+	prefixLensEncoder *int32DeltaBinaryPackedEncoder
+	suffixEncoder     *byteArrayDeltaLengthEncoder
+	prev              []byte
+	data              []byte
+}
+
+func newByteArrayDeltaEncoder(length int, blockSize int, numMiniBlocks int) *byteArrayDeltaEncoder {
+	return &byteArrayDeltaEncoder{
+		length:            length,
+		prefixLensEncoder: newInt32DeltaBinaryPackedEncoder(blockSize, numMiniBlocks),
+		suffixEncoder:     newByteArrayDeltaLengthEncoder(blockSize, numMiniBlocks),
+	}
+}
+
+func (e *byteArrayDeltaEncoder) encode(values interface{}) error {
+	return encodeByteArray(e, values)
+}
+
+func (e *byteArrayDeltaEncoder) encodeByteSlice(values [][]byte) error {
+	prefixLens := make([]int32, len(values))
+	suffixes := make([][]byte, len(values))
+	for i, v := range values {
+		if e.length > 0 && len(v) != e.length {
+			return fmt.Errorf("bytearray/delta: invalid value length %d (must be %d)", len(v), e.length)
+		}
+		n := 0
+		for n < len(v) && n < len(e.prev) && v[n] == e.prev[n] {
+			n++
+		}
+		prefixLens[i] = int32(n)
+		suffixes[i] = v[n:]
+		e.prev = append(e.prev[:0], v...)
+	}
+	if err := e.prefixLensEncoder.encodeInt32(prefixLens); err != nil {
+		return err
+	}
+	return e.suffixEncoder.encodeByteSlice(suffixes)
+}
+
+func (e *byteArrayDeltaEncoder) size() int {
+	return e.prefixLensEncoder.size() + e.suffixEncoder.size()
+}
+
+func (e *byteArrayDeltaEncoder) flush() []byte {
+	e.data = append(e.data[:0], e.prefixLensEncoder.flush()...)
+	e.data = append(e.data, e.suffixEncoder.flush()...)
+	// every page starts with an empty previous value
+	e.prev = e.prev[:0]
+	return e.data
+}
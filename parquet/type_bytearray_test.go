@@ -1,6 +1,8 @@
 package parquet
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 )
 
@@ -82,3 +84,94 @@ func TestByteArrayDeltaDecoder(t *testing.T) {
 		},
 	})
 }
+
+func checkByteArrayEncoder(t *testing.T, e valuesEncoder, d valuesDecoder, values [][]byte) {
+	t.Helper()
+	if err := e.encode(values); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	data := append([]byte{}, e.flush()...)
+	if err := d.init(data); err != nil {
+		t.Fatalf("%d values: failed to init decoder: %s", len(values), err)
+	}
+	got := make([][]byte, len(values))
+	if err := d.decode(got); err != nil {
+		t.Fatalf("%d values: failed to decode: %s", len(values), err)
+	}
+	for i := range values {
+		if !bytes.Equal(got[i], values[i]) {
+			t.Fatalf("value %d: got %q, want %q", i, got[i], values[i])
+		}
+	}
+	if err := d.decode(make([][]byte, 1)); err != errNED {
+		t.Errorf("%d values: got %v after all values are decoded, want errNED", len(values), err)
+	}
+}
+
+func byteArrayEncoderTestValues() [][][]byte {
+	var urls, keys [][]byte
+	for i := 0; i < 500; i++ {
+		urls = append(urls, []byte(fmt.Sprintf("https://example.com/path/%03d/page-%d.html", i/7, i)))
+		keys = append(keys, []byte(fmt.Sprintf("%08d", i*37)))
+	}
+	return [][][]byte{
+		nil,
+		{[]byte("single")},
+		{[]byte(""), []byte("")},
+		{[]byte("ABC"), []byte(""), []byte("ABCDEF"), []byte("AB"), []byte("ABC")},
+		urls,
+		keys,
+	}
+}
+
+func TestByteArrayDeltaLengthEncoder(t *testing.T) {
+	// the same data as in TestByteArrayDeltaLengthDecoder
+	e := newByteArrayDeltaLengthEncoder(128, 4)
+	if err := e.encode([][]byte{[]byte("Hello"), []byte("World"), []byte("Foobar"), []byte("ABCDEF"), []byte("")}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	want := []byte{
+		0x80, 0x01, 0x04, 0x05, 0x0A, 0x0B, 0x03, 0x00, 0x00, 0x00,
+		0xBE, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x48, 0x65, 0x6C, 0x6C, 0x6F, 0x57, 0x6F, 0x72,
+		0x6C, 0x64, 0x46, 0x6F, 0x6F, 0x62, 0x61, 0x72, 0x41, 0x42,
+		0x43, 0x44, 0x45, 0x46,
+	}
+	if got := e.flush(); !bytes.Equal(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	for _, values := range byteArrayEncoderTestValues() {
+		checkByteArrayEncoder(t, e, &byteArrayDeltaLengthDecoder{}, values)
+	}
+}
+
+func TestByteArrayDeltaEncoder(t *testing.T) {
+	// the same data as in TestByteArrayDeltaDecoder
+	e := newByteArrayDeltaEncoder(0, 128, 4)
+	if err := e.encode([][]byte{[]byte(""), []byte("ABC"), []byte("ABCDEF"), []byte("Hello"), []byte("World"), []byte("")}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	want := []byte{
+		0x80, 0x01, 0x04, 0x06, 0x00, 0x05, 0x03, 0x00, 0x00, 0x00,
+		0x33, 0x36, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x80, 0x01, 0x04, 0x06, 0x00, 0x09, 0x04, 0x00,
+		0x00, 0x00, 0x58, 0x57, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x41, 0x42,
+		0x43, 0x44, 0x45, 0x46, 0x48, 0x65, 0x6C, 0x6C, 0x6F, 0x57,
+		0x6F, 0x72, 0x6C, 0x64,
+	}
+	if got := e.flush(); !bytes.Equal(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	for _, values := range byteArrayEncoderTestValues() {
+		checkByteArrayEncoder(t, e, &byteArrayDeltaDecoder{}, values)
+	}
+
+	fixed := newByteArrayDeltaEncoder(4, 128, 4)
+	checkByteArrayEncoder(t, fixed, &byteArrayDeltaDecoder{}, [][]byte{[]byte("abcd"), []byte("abce"), []byte("abce"), []byte("bbbb")})
+	if err := fixed.encode([][]byte{[]byte("abc")}); err == nil {
+		t.Errorf("error expected for a value of invalid length")
+	}
+}
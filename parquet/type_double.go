@@ -3,6 +3,7 @@ package parquet
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math"
 )
 
@@ -53,6 +54,14 @@ func (d *doublePlainDecoder) decodeFloat64(dst []float64) error {
 	return nil
 }
 
+func (d *doublePlainDecoder) skip(n int) error {
+	if len(d.data) < 8*n {
+		return errNED
+	}
+	d.data = d.data[8*n:]
+	return nil
+}
+
 type doubleDictDecoder struct {
 	dictDecoder
 
@@ -79,3 +88,140 @@ func (d *doubleDictDecoder) decodeFloat64(dst []float64) error {
 	}
 	return nil
 }
+
+type doubleEncoder interface {
+	encodeFloat64(values []float64) error
+}
+
+func encodeDouble(e doubleEncoder, values interface{}) error {
+	switch values := values.(type) {
+	case []float64:
+		return e.encodeFloat64(values)
+	case []interface{}:
+		b := make([]float64, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.(float64); !ok {
+				return fmt.Errorf("double: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeFloat64(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type doublePlainEncoder struct {
+	data []byte
+}
+
+func (e *doublePlainEncoder) encode(values interface{}) error {
+	return encodeDouble(e, values)
+}
+
+func (e *doublePlainEncoder) encodeFloat64(values []float64) error {
+	var buf [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+		e.data = append(e.data, buf[:]...)
+	}
+	return nil
+}
+
+func (e *doublePlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *doublePlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	return data
+}
+
+type doubleByteStreamSplitDecoder struct {
+	byteStreamSplitDecoder
+}
+
+func newDoubleByteStreamSplitDecoder() *doubleByteStreamSplitDecoder {
+	return &doubleByteStreamSplitDecoder{byteStreamSplitDecoder{width: 8}}
+}
+
+func (d *doubleByteStreamSplitDecoder) decode(dst interface{}) error {
+	return decodeDouble(d, dst)
+}
+
+func (d *doubleByteStreamSplitDecoder) decodeFloat64(dst []float64) error {
+	var b [8]byte
+	for i := 0; i < len(dst); i++ {
+		if err := d.next(b[:]); err != nil {
+			return err
+		}
+		dst[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	}
+	return nil
+}
+
+type doubleByteStreamSplitEncoder struct {
+	byteStreamSplitEncoder
+}
+
+func newDoubleByteStreamSplitEncoder() *doubleByteStreamSplitEncoder {
+	return &doubleByteStreamSplitEncoder{byteStreamSplitEncoder{width: 8}}
+}
+
+func (e *doubleByteStreamSplitEncoder) encode(values interface{}) error {
+	return encodeDouble(e, values)
+}
+
+func (e *doubleByteStreamSplitEncoder) encodeFloat64(values []float64) error {
+	var b [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		e.values = append(e.values, b[:]...)
+	}
+	return nil
+}
+
+type doubleDictEncoder struct {
+	dictEncoder
+
+	index   map[uint64]int32
+	entries []float64
+}
+
+func newDoubleDictEncoder() *doubleDictEncoder {
+	return &doubleDictEncoder{
+		dictEncoder: dictEncoder{dict: &doublePlainEncoder{}},
+		index:       make(map[uint64]int32),
+	}
+}
+
+func (e *doubleDictEncoder) encode(values interface{}) error {
+	return encodeDouble(e, values)
+}
+
+func (e *doubleDictEncoder) encodeFloat64(values []float64) error {
+	n := len(e.entries)
+	for _, v := range values {
+		k, ok := e.index[math.Float64bits(v)]
+		if !ok {
+			k = int32(len(e.entries))
+			e.index[math.Float64bits(v)] = k
+			e.entries = append(e.entries, v)
+		}
+		e.keys = append(e.keys, k)
+	}
+	e.raw += 8 * len(values)
+	e.count = len(e.entries)
+	return e.dict.encode(e.entries[n:])
+}
+
+func (e *doubleDictEncoder) fallBackTo(enc valuesEncoder) error {
+	values := make([]float64, len(e.keys))
+	for i, k := range e.keys {
+		values[i] = e.entries[k]
+	}
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return enc.encode(values)
+}
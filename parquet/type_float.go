@@ -3,6 +3,7 @@ package parquet
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math"
 )
 
@@ -53,6 +54,14 @@ func (d *floatPlainDecoder) decodeFloat32(dst []float32) error {
 	return nil
 }
 
+func (d *floatPlainDecoder) skip(n int) error {
+	if len(d.data) < 4*n {
+		return errNED
+	}
+	d.data = d.data[4*n:]
+	return nil
+}
+
 type floatDictDecoder struct {
 	dictDecoder
 
@@ -79,3 +88,140 @@ func (d *floatDictDecoder) decodeFloat32(dst []float32) error {
 	}
 	return nil
 }
+
+type floatEncoder interface {
+	encodeFloat32(values []float32) error
+}
+
+func encodeFloat(e floatEncoder, values interface{}) error {
+	switch values := values.(type) {
+	case []float32:
+		return e.encodeFloat32(values)
+	case []interface{}:
+		b := make([]float32, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.(float32); !ok {
+				return fmt.Errorf("float: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeFloat32(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type floatPlainEncoder struct {
+	data []byte
+}
+
+func (e *floatPlainEncoder) encode(values interface{}) error {
+	return encodeFloat(e, values)
+}
+
+func (e *floatPlainEncoder) encodeFloat32(values []float32) error {
+	var buf [4]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+		e.data = append(e.data, buf[:]...)
+	}
+	return nil
+}
+
+func (e *floatPlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *floatPlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	return data
+}
+
+type floatByteStreamSplitDecoder struct {
+	byteStreamSplitDecoder
+}
+
+func newFloatByteStreamSplitDecoder() *floatByteStreamSplitDecoder {
+	return &floatByteStreamSplitDecoder{byteStreamSplitDecoder{width: 4}}
+}
+
+func (d *floatByteStreamSplitDecoder) decode(dst interface{}) error {
+	return decodeFloat(d, dst)
+}
+
+func (d *floatByteStreamSplitDecoder) decodeFloat32(dst []float32) error {
+	var b [4]byte
+	for i := 0; i < len(dst); i++ {
+		if err := d.next(b[:]); err != nil {
+			return err
+		}
+		dst[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	}
+	return nil
+}
+
+type floatByteStreamSplitEncoder struct {
+	byteStreamSplitEncoder
+}
+
+func newFloatByteStreamSplitEncoder() *floatByteStreamSplitEncoder {
+	return &floatByteStreamSplitEncoder{byteStreamSplitEncoder{width: 4}}
+}
+
+func (e *floatByteStreamSplitEncoder) encode(values interface{}) error {
+	return encodeFloat(e, values)
+}
+
+func (e *floatByteStreamSplitEncoder) encodeFloat32(values []float32) error {
+	var b [4]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(v))
+		e.values = append(e.values, b[:]...)
+	}
+	return nil
+}
+
+type floatDictEncoder struct {
+	dictEncoder
+
+	index   map[uint32]int32
+	entries []float32
+}
+
+func newFloatDictEncoder() *floatDictEncoder {
+	return &floatDictEncoder{
+		dictEncoder: dictEncoder{dict: &floatPlainEncoder{}},
+		index:       make(map[uint32]int32),
+	}
+}
+
+func (e *floatDictEncoder) encode(values interface{}) error {
+	return encodeFloat(e, values)
+}
+
+func (e *floatDictEncoder) encodeFloat32(values []float32) error {
+	n := len(e.entries)
+	for _, v := range values {
+		k, ok := e.index[math.Float32bits(v)]
+		if !ok {
+			k = int32(len(e.entries))
+			e.index[math.Float32bits(v)] = k
+			e.entries = append(e.entries, v)
+		}
+		e.keys = append(e.keys, k)
+	}
+	e.raw += 4 * len(values)
+	e.count = len(e.entries)
+	return e.dict.encode(e.entries[n:])
+}
+
+func (e *floatDictEncoder) fallBackTo(enc valuesEncoder) error {
+	values := make([]float32, len(e.keys))
+	for i, k := range e.keys {
+		values[i] = e.entries[k]
+	}
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return enc.encode(values)
+}
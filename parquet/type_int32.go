@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/bits"
 )
 
 type int32Decoder interface {
@@ -53,6 +54,14 @@ func (d *int32PlainDecoder) decodeInt32(dst []int32) error {
 	return nil
 }
 
+func (d *int32PlainDecoder) skip(n int) error {
+	if len(d.data) < 4*n {
+		return errNED
+	}
+	d.data = d.data[4*n:]
+	return nil
+}
+
 type int32DictDecoder struct {
 	dictDecoder
 
@@ -107,9 +116,9 @@ func (d *int32DeltaBinaryPackedDecoder) init(data []byte) error {
 	if err := d.readPageHeader(); err != nil {
 		return err
 	}
-	if err := d.readBlockHeader(); err != nil {
-		return err
-	}
+	// the first block header is read when the first delta is needed, there
+	// are no blocks at all if there are less than 2 values
+	d.miniBlock = int(d.numMiniBlocks)
 
 	return nil
 }
@@ -197,7 +206,7 @@ func (d *int32DeltaBinaryPackedDecoder) decodeInt32(dst []int32) error {
 		if d.i >= int(d.numValues) {
 			return errNED
 		}
-		if d.i%8 == 0 {
+		if d.i%8 == 0 && d.i+1 < int(d.numValues) {
 			if d.i%int(d.miniBlockSize) == 0 {
 				if d.miniBlock >= int(d.numMiniBlocks) {
 					if err := d.readBlockHeader(); err != nil {
@@ -219,7 +228,7 @@ func (d *int32DeltaBinaryPackedDecoder) decodeInt32(dst []int32) error {
 			d.miniBlockValues = d.unpacker(d.data[:w])
 			d.miniBlockPos += w
 			d.data = d.data[w:]
-			if d.i+8 >= int(d.numValues) {
+			if d.i+8 >= int(d.numValues)-1 {
 				// make sure that all data is consumed
 				// this is needed for byte array decoders
 				d.data = d.data[int(d.miniBlockSize)/8*w-d.miniBlockPos:]
@@ -232,3 +241,253 @@ func (d *int32DeltaBinaryPackedDecoder) decodeInt32(dst []int32) error {
 
 	return nil
 }
+
+type int32Encoder interface {
+	encodeInt32(values []int32) error
+}
+
+func encodeInt32(e int32Encoder, values interface{}) error {
+	switch values := values.(type) {
+	case []int32:
+		return e.encodeInt32(values)
+	case []interface{}:
+		b := make([]int32, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.(int32); !ok {
+				return fmt.Errorf("int32: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeInt32(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type int32PlainEncoder struct {
+	data []byte
+}
+
+func (e *int32PlainEncoder) encode(values interface{}) error {
+	return encodeInt32(e, values)
+}
+
+func (e *int32PlainEncoder) encodeInt32(values []int32) error {
+	var buf [4]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint32(buf[:], uint32(v))
+		e.data = append(e.data, buf[:]...)
+	}
+	return nil
+}
+
+func (e *int32PlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *int32PlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	return data
+}
+
+type int32ByteStreamSplitDecoder struct {
+	byteStreamSplitDecoder
+}
+
+func newInt32ByteStreamSplitDecoder() *int32ByteStreamSplitDecoder {
+	return &int32ByteStreamSplitDecoder{byteStreamSplitDecoder{width: 4}}
+}
+
+func (d *int32ByteStreamSplitDecoder) decode(dst interface{}) error {
+	return decodeInt32(d, dst)
+}
+
+func (d *int32ByteStreamSplitDecoder) decodeInt32(dst []int32) error {
+	var b [4]byte
+	for i := 0; i < len(dst); i++ {
+		if err := d.next(b[:]); err != nil {
+			return err
+		}
+		dst[i] = int32(binary.LittleEndian.Uint32(b[:]))
+	}
+	return nil
+}
+
+type int32ByteStreamSplitEncoder struct {
+	byteStreamSplitEncoder
+}
+
+func newInt32ByteStreamSplitEncoder() *int32ByteStreamSplitEncoder {
+	return &int32ByteStreamSplitEncoder{byteStreamSplitEncoder{width: 4}}
+}
+
+func (e *int32ByteStreamSplitEncoder) encode(values interface{}) error {
+	return encodeInt32(e, values)
+}
+
+func (e *int32ByteStreamSplitEncoder) encodeInt32(values []int32) error {
+	var b [4]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint32(b[:], uint32(v))
+		e.values = append(e.values, b[:]...)
+	}
+	return nil
+}
+
+type int32DictEncoder struct {
+	dictEncoder
+
+	index   map[int32]int32
+	entries []int32
+}
+
+func newInt32DictEncoder() *int32DictEncoder {
+	return &int32DictEncoder{
+		dictEncoder: dictEncoder{dict: &int32PlainEncoder{}},
+		index:       make(map[int32]int32),
+	}
+}
+
+func (e *int32DictEncoder) encode(values interface{}) error {
+	return encodeInt32(e, values)
+}
+
+func (e *int32DictEncoder) encodeInt32(values []int32) error {
+	n := len(e.entries)
+	for _, v := range values {
+		k, ok := e.index[v]
+		if !ok {
+			k = int32(len(e.entries))
+			e.index[v] = k
+			e.entries = append(e.entries, v)
+		}
+		e.keys = append(e.keys, k)
+	}
+	e.raw += 4 * len(values)
+	e.count = len(e.entries)
+	return e.dict.encode(e.entries[n:])
+}
+
+func (e *int32DictEncoder) fallBackTo(enc valuesEncoder) error {
+	values := make([]int32, len(e.keys))
+	for i, k := range e.keys {
+		values[i] = e.entries[k]
+	}
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return enc.encode(values)
+}
+
+// int32DeltaBinaryPackedEncoder encodes values using DELTA_BINARY_PACKED
+// encoding. Deltas are computed with wrap-around arithmetic, the same way as
+// int32DeltaBinaryPackedDecoder applies them.
+type int32DeltaBinaryPackedEncoder struct {
+	blockSize     int
+	numMiniBlocks int
+
+	numValues int
+	first     int32
+	prev      int32
+	deltas    []int32 // deltas of the current block
+	blocks    []byte
+	data      []byte
+}
+
+func newInt32DeltaBinaryPackedEncoder(blockSize int, numMiniBlocks int) *int32DeltaBinaryPackedEncoder {
+	return &int32DeltaBinaryPackedEncoder{
+		blockSize:     blockSize,
+		numMiniBlocks: numMiniBlocks,
+		deltas:        make([]int32, 0, blockSize),
+	}
+}
+
+func (e *int32DeltaBinaryPackedEncoder) encode(values interface{}) error {
+	return encodeInt32(e, values)
+}
+
+func (e *int32DeltaBinaryPackedEncoder) encodeInt32(values []int32) error {
+	for _, v := range values {
+		if e.numValues == 0 {
+			e.first = v
+		} else {
+			e.deltas = append(e.deltas, v-e.prev)
+			if len(e.deltas) == e.blockSize {
+				e.writeBlock()
+			}
+		}
+		e.prev = v
+		e.numValues++
+	}
+	return nil
+}
+
+// writeBlock writes deltas of the current block. The last miniblock with
+// deltas is padded with zeros, miniblocks without deltas are not written
+// (their bit widths are 0).
+func (e *int32DeltaBinaryPackedEncoder) writeBlock() {
+	minDelta := e.deltas[0]
+	for _, d := range e.deltas[1:] {
+		if d < minDelta {
+			minDelta = d
+		}
+	}
+	e.blocks = appendZigZagVarInt(e.blocks, int64(minDelta))
+
+	widthsPos := len(e.blocks)
+	for i := 0; i < e.numMiniBlocks; i++ {
+		e.blocks = append(e.blocks, 0)
+	}
+
+	miniBlockSize := e.blockSize / e.numMiniBlocks
+	var packed [32]byte
+	for i := 0; i*miniBlockSize < len(e.deltas); i++ {
+		miniBlock := e.deltas[i*miniBlockSize:]
+		if len(miniBlock) > miniBlockSize {
+			miniBlock = miniBlock[:miniBlockSize]
+		}
+		var max uint32
+		for _, d := range miniBlock {
+			if u := uint32(d - minDelta); u > max {
+				max = u
+			}
+		}
+		w := bits.Len32(max)
+		e.blocks[widthsPos+i] = byte(w)
+
+		pack := pack8Int32FuncByWidth[w]
+		for j := 0; j < miniBlockSize; j += 8 {
+			var a [8]int32
+			for k := 0; k < 8 && j+k < len(miniBlock); k++ {
+				a[k] = miniBlock[j+k] - minDelta
+			}
+			pack(packed[:w], a)
+			e.blocks = append(e.blocks, packed[:w]...)
+		}
+	}
+
+	e.deltas = e.deltas[:0]
+}
+
+// size returns the size of the written blocks plus 4 bytes for every delta
+// of the current block.
+func (e *int32DeltaBinaryPackedEncoder) size() int {
+	return len(e.blocks) + 4*len(e.deltas)
+}
+
+func (e *int32DeltaBinaryPackedEncoder) flush() []byte {
+	if len(e.deltas) > 0 {
+		e.writeBlock()
+	}
+
+	// page-header := <block size in values> <number of miniblocks in a block> <total value count> <first value>
+	e.data = appendVarInt(e.data[:0], uint64(e.blockSize))
+	e.data = appendVarInt(e.data, uint64(e.numMiniBlocks))
+	e.data = appendVarInt(e.data, uint64(e.numValues))
+	e.data = appendZigZagVarInt(e.data, int64(e.first))
+	e.data = append(e.data, e.blocks...)
+
+	e.numValues = 0
+	e.blocks = e.blocks[:0]
+	return e.data
+}
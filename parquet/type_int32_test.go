@@ -1,6 +1,10 @@
 package parquet
 
 import (
+	"bytes"
+	"math"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -160,3 +164,72 @@ func TestInt32DeltaBianryPackedErrors(t *testing.T) {
 		}
 	}
 }
+
+func checkInt32DeltaBinaryPacked(t *testing.T, e *int32DeltaBinaryPackedEncoder, values []int32) {
+	t.Helper()
+	if err := e.encode(values); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	data := append([]byte{}, e.flush()...)
+	d := &int32DeltaBinaryPackedDecoder{}
+	if err := d.init(data); err != nil {
+		t.Fatalf("block size %d/%d, %d values: failed to init decoder: %s", e.blockSize, e.numMiniBlocks, len(values), err)
+	}
+	got := make([]int32, len(values))
+	if err := d.decode(got); err != nil {
+		t.Fatalf("block size %d/%d, %d values: failed to decode: %s", e.blockSize, e.numMiniBlocks, len(values), err)
+	}
+	if !reflect.DeepEqual(got, append([]int32{}, values...)) {
+		t.Errorf("block size %d/%d: got %v, want %v", e.blockSize, e.numMiniBlocks, got, values)
+	}
+	if len(d.data) != 0 {
+		t.Errorf("block size %d/%d, %d values: %d bytes left after decoding", e.blockSize, e.numMiniBlocks, len(values), len(d.data))
+	}
+	if err := d.decode(make([]int32, 1)); err != errNED {
+		t.Errorf("block size %d/%d, %d values: got %v after all values are decoded, want errNED", e.blockSize, e.numMiniBlocks, len(values), err)
+	}
+}
+
+func TestInt32DeltaBinaryPackedEncoder(t *testing.T) {
+	// the same data as in TestInt32DeltaBianryPackedDecoder
+	e := newInt32DeltaBinaryPackedEncoder(128, 8)
+	if err := e.encode([]int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	want := []byte{
+		0x80, 0x01, 0x08, 0x14, 0x02, 0x02, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	if got := e.flush(); !bytes.Equal(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	random := make([]int32, 1000)
+	for i := range random {
+		random[i] = int32(r.Uint32())
+	}
+	small := make([]int32, 1000)
+	for i := range small {
+		small[i] = 1000 + int32(r.Intn(100)) - 50
+	}
+	tests := [][]int32{
+		nil,
+		{7},
+		{math.MinInt32, math.MaxInt32},
+		{math.MaxInt32, math.MinInt32, math.MaxInt32, 0, -100, 234},
+		repeatInt32(129, -5),
+		repeatInt32(257, math.MinInt32),
+		random,
+		small,
+	}
+	for _, sizes := range [][2]int{{128, 4}, {128, 1}, {256, 8}, {1024, 32}} {
+		e := newInt32DeltaBinaryPackedEncoder(sizes[0], sizes[1])
+		for _, values := range tests {
+			checkInt32DeltaBinaryPacked(t, e, values)
+		}
+		for n := 0; n < 300; n++ {
+			checkInt32DeltaBinaryPacked(t, e, small[:n])
+		}
+	}
+}
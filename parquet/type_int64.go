@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/bits"
 )
 
 type int64Decoder interface {
@@ -53,6 +54,14 @@ func (d *int64PlainDecoder) decodeInt64(dst []int64) error {
 	return nil
 }
 
+func (d *int64PlainDecoder) skip(n int) error {
+	if len(d.data) < 8*n {
+		return errNED
+	}
+	d.data = d.data[8*n:]
+	return nil
+}
+
 type int64DictDecoder struct {
 	dictDecoder
 
@@ -107,9 +116,9 @@ func (d *int64DeltaBinaryPackedDecoder) init(data []byte) error {
 	if err := d.readPageHeader(); err != nil {
 		return err
 	}
-	if err := d.readBlockHeader(); err != nil {
-		return err
-	}
+	// the first block header is read when the first delta is needed, there
+	// are no blocks at all if there are less than 2 values
+	d.miniBlock = int(d.numMiniBlocks)
 
 	return nil
 }
@@ -197,7 +206,7 @@ func (d *int64DeltaBinaryPackedDecoder) decodeInt64(dst []int64) error {
 		if d.i >= int(d.numValues) {
 			return errNED
 		}
-		if d.i%8 == 0 {
+		if d.i%8 == 0 && d.i+1 < int(d.numValues) {
 			if d.i%int(d.miniBlockSize) == 0 {
 				if d.miniBlock >= int(d.numMiniBlocks) {
 					if err := d.readBlockHeader(); err != nil {
@@ -219,7 +228,7 @@ func (d *int64DeltaBinaryPackedDecoder) decodeInt64(dst []int64) error {
 			d.miniBlockValues = d.unpacker(d.data[:w])
 			d.miniBlockPos += w
 			d.data = d.data[w:]
-			if d.i+8 >= int(d.numValues) {
+			if d.i+8 >= int(d.numValues)-1 {
 				// make sure that all data is consumed
 				// this is needed for byte array decoders
 				d.data = d.data[int(d.miniBlockSize)/8*w-d.miniBlockPos:]
@@ -232,3 +241,253 @@ func (d *int64DeltaBinaryPackedDecoder) decodeInt64(dst []int64) error {
 
 	return nil
 }
+
+type int64Encoder interface {
+	encodeInt64(values []int64) error
+}
+
+func encodeInt64(e int64Encoder, values interface{}) error {
+	switch values := values.(type) {
+	case []int64:
+		return e.encodeInt64(values)
+	case []interface{}:
+		b := make([]int64, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.(int64); !ok {
+				return fmt.Errorf("int64: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeInt64(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type int64PlainEncoder struct {
+	data []byte
+}
+
+func (e *int64PlainEncoder) encode(values interface{}) error {
+	return encodeInt64(e, values)
+}
+
+func (e *int64PlainEncoder) encodeInt64(values []int64) error {
+	var buf [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(buf[:], uint64(v))
+		e.data = append(e.data, buf[:]...)
+	}
+	return nil
+}
+
+func (e *int64PlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *int64PlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	return data
+}
+
+type int64ByteStreamSplitDecoder struct {
+	byteStreamSplitDecoder
+}
+
+func newInt64ByteStreamSplitDecoder() *int64ByteStreamSplitDecoder {
+	return &int64ByteStreamSplitDecoder{byteStreamSplitDecoder{width: 8}}
+}
+
+func (d *int64ByteStreamSplitDecoder) decode(dst interface{}) error {
+	return decodeInt64(d, dst)
+}
+
+func (d *int64ByteStreamSplitDecoder) decodeInt64(dst []int64) error {
+	var b [8]byte
+	for i := 0; i < len(dst); i++ {
+		if err := d.next(b[:]); err != nil {
+			return err
+		}
+		dst[i] = int64(binary.LittleEndian.Uint64(b[:]))
+	}
+	return nil
+}
+
+type int64ByteStreamSplitEncoder struct {
+	byteStreamSplitEncoder
+}
+
+func newInt64ByteStreamSplitEncoder() *int64ByteStreamSplitEncoder {
+	return &int64ByteStreamSplitEncoder{byteStreamSplitEncoder{width: 8}}
+}
+
+func (e *int64ByteStreamSplitEncoder) encode(values interface{}) error {
+	return encodeInt64(e, values)
+}
+
+func (e *int64ByteStreamSplitEncoder) encodeInt64(values []int64) error {
+	var b [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(b[:], uint64(v))
+		e.values = append(e.values, b[:]...)
+	}
+	return nil
+}
+
+type int64DictEncoder struct {
+	dictEncoder
+
+	index   map[int64]int32
+	entries []int64
+}
+
+func newInt64DictEncoder() *int64DictEncoder {
+	return &int64DictEncoder{
+		dictEncoder: dictEncoder{dict: &int64PlainEncoder{}},
+		index:       make(map[int64]int32),
+	}
+}
+
+func (e *int64DictEncoder) encode(values interface{}) error {
+	return encodeInt64(e, values)
+}
+
+func (e *int64DictEncoder) encodeInt64(values []int64) error {
+	n := len(e.entries)
+	for _, v := range values {
+		k, ok := e.index[v]
+		if !ok {
+			k = int32(len(e.entries))
+			e.index[v] = k
+			e.entries = append(e.entries, v)
+		}
+		e.keys = append(e.keys, k)
+	}
+	e.raw += 8 * len(values)
+	e.count = len(e.entries)
+	return e.dict.encode(e.entries[n:])
+}
+
+func (e *int64DictEncoder) fallBackTo(enc valuesEncoder) error {
+	values := make([]int64, len(e.keys))
+	for i, k := range e.keys {
+		values[i] = e.entries[k]
+	}
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return enc.encode(values)
+}
+
+// int64DeltaBinaryPackedEncoder encodes values using DELTA_BINARY_PACKED
+// encoding. Deltas are computed with wrap-around arithmetic, the same way as
+// int64DeltaBinaryPackedDecoder applies them.
+type int64DeltaBinaryPackedEncoder struct {
+	blockSize     int
+	numMiniBlocks int
+
+	numValues int
+	first     int64
+	prev      int64
+	deltas    []int64 // deltas of the current block
+	blocks    []byte
+	data      []byte
+}
+
+func newInt64DeltaBinaryPackedEncoder(blockSize int, numMiniBlocks int) *int64DeltaBinaryPackedEncoder {
+	return &int64DeltaBinaryPackedEncoder{
+		blockSize:     blockSize,
+		numMiniBlocks: numMiniBlocks,
+		deltas:        make([]int64, 0, blockSize),
+	}
+}
+
+func (e *int64DeltaBinaryPackedEncoder) encode(values interface{}) error {
+	return encodeInt64(e, values)
+}
+
+func (e *int64DeltaBinaryPackedEncoder) encodeInt64(values []int64) error {
+	for _, v := range values {
+		if e.numValues == 0 {
+			e.first = v
+		} else {
+			e.deltas = append(e.deltas, v-e.prev)
+			if len(e.deltas) == e.blockSize {
+				e.writeBlock()
+			}
+		}
+		e.prev = v
+		e.numValues++
+	}
+	return nil
+}
+
+// writeBlock writes deltas of the current block. The last miniblock with
+// deltas is padded with zeros, miniblocks without deltas are not written
+// (their bit widths are 0).
+func (e *int64DeltaBinaryPackedEncoder) writeBlock() {
+	minDelta := e.deltas[0]
+	for _, d := range e.deltas[1:] {
+		if d < minDelta {
+			minDelta = d
+		}
+	}
+	e.blocks = appendZigZagVarInt(e.blocks, minDelta)
+
+	widthsPos := len(e.blocks)
+	for i := 0; i < e.numMiniBlocks; i++ {
+		e.blocks = append(e.blocks, 0)
+	}
+
+	miniBlockSize := e.blockSize / e.numMiniBlocks
+	var packed [64]byte
+	for i := 0; i*miniBlockSize < len(e.deltas); i++ {
+		miniBlock := e.deltas[i*miniBlockSize:]
+		if len(miniBlock) > miniBlockSize {
+			miniBlock = miniBlock[:miniBlockSize]
+		}
+		var max uint64
+		for _, d := range miniBlock {
+			if u := uint64(d - minDelta); u > max {
+				max = u
+			}
+		}
+		w := bits.Len64(max)
+		e.blocks[widthsPos+i] = byte(w)
+
+		pack := pack8Int64FuncByWidth[w]
+		for j := 0; j < miniBlockSize; j += 8 {
+			var a [8]int64
+			for k := 0; k < 8 && j+k < len(miniBlock); k++ {
+				a[k] = miniBlock[j+k] - minDelta
+			}
+			pack(packed[:w], a)
+			e.blocks = append(e.blocks, packed[:w]...)
+		}
+	}
+
+	e.deltas = e.deltas[:0]
+}
+
+// size returns the size of the written blocks plus 8 bytes for every delta
+// of the current block.
+func (e *int64DeltaBinaryPackedEncoder) size() int {
+	return len(e.blocks) + 8*len(e.deltas)
+}
+
+func (e *int64DeltaBinaryPackedEncoder) flush() []byte {
+	if len(e.deltas) > 0 {
+		e.writeBlock()
+	}
+
+	// page-header := <block size in values> <number of miniblocks in a block> <total value count> <first value>
+	e.data = appendVarInt(e.data[:0], uint64(e.blockSize))
+	e.data = appendVarInt(e.data, uint64(e.numMiniBlocks))
+	e.data = appendVarInt(e.data, uint64(e.numValues))
+	e.data = appendZigZagVarInt(e.data, e.first)
+	e.data = append(e.data, e.blocks...)
+
+	e.numValues = 0
+	e.blocks = e.blocks[:0]
+	return e.data
+}
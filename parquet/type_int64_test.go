@@ -1,6 +1,10 @@
 package parquet
 
 import (
+	"bytes"
+	"math"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -116,3 +120,80 @@ func TestInt64DeltaBianryPackedDecoder(t *testing.T) {
 		},
 	})
 }
+
+func repeatInt64(count int, value int64) (a []int64) {
+	for i := 0; i < count; i++ {
+		a = append(a, value)
+	}
+	return
+}
+
+func checkInt64DeltaBinaryPacked(t *testing.T, e *int64DeltaBinaryPackedEncoder, values []int64) {
+	t.Helper()
+	if err := e.encode(values); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	data := append([]byte{}, e.flush()...)
+	d := &int64DeltaBinaryPackedDecoder{}
+	if err := d.init(data); err != nil {
+		t.Fatalf("block size %d/%d, %d values: failed to init decoder: %s", e.blockSize, e.numMiniBlocks, len(values), err)
+	}
+	got := make([]int64, len(values))
+	if err := d.decode(got); err != nil {
+		t.Fatalf("block size %d/%d, %d values: failed to decode: %s", e.blockSize, e.numMiniBlocks, len(values), err)
+	}
+	if !reflect.DeepEqual(got, append([]int64{}, values...)) {
+		t.Errorf("block size %d/%d: got %v, want %v", e.blockSize, e.numMiniBlocks, got, values)
+	}
+	if len(d.data) != 0 {
+		t.Errorf("block size %d/%d, %d values: %d bytes left after decoding", e.blockSize, e.numMiniBlocks, len(values), len(d.data))
+	}
+	if err := d.decode(make([]int64, 1)); err != errNED {
+		t.Errorf("block size %d/%d, %d values: got %v after all values are decoded, want errNED", e.blockSize, e.numMiniBlocks, len(values), err)
+	}
+}
+
+func TestInt64DeltaBinaryPackedEncoder(t *testing.T) {
+	// the same data as in TestInt64DeltaBianryPackedDecoder
+	e := newInt64DeltaBinaryPackedEncoder(128, 8)
+	if err := e.encode([]int64{-7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	want := []byte{
+		0x80, 0x01, 0x08, 0x11, 0x0D, 0x02, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	if got := e.flush(); !bytes.Equal(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	random := make([]int64, 1000)
+	for i := range random {
+		random[i] = int64(r.Uint64())
+	}
+	small := make([]int64, 1000)
+	for i := range small {
+		small[i] = 1000 + int64(r.Intn(100)) - 50
+	}
+	tests := [][]int64{
+		nil,
+		{7},
+		{math.MinInt64, math.MaxInt64},
+		{math.MaxInt64, math.MinInt64, math.MaxInt64, 0, -100, 234},
+		{math.MinInt64, math.MaxInt64, math.MinInt64, -1, 1, math.MinInt64},
+		repeatInt64(129, -5),
+		repeatInt64(257, math.MinInt64),
+		random,
+		small,
+	}
+	for _, sizes := range [][2]int{{128, 4}, {128, 1}, {256, 8}, {1024, 32}} {
+		e := newInt64DeltaBinaryPackedEncoder(sizes[0], sizes[1])
+		for _, values := range tests {
+			checkInt64DeltaBinaryPacked(t, e, values)
+		}
+		for n := 0; n < 300; n++ {
+			checkInt64DeltaBinaryPacked(t, e, small[:n])
+		}
+	}
+}
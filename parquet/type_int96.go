@@ -2,6 +2,7 @@ package parquet
 
 import (
 	"errors"
+	"fmt"
 )
 
 type Int96 [12]byte
@@ -52,6 +53,14 @@ func (d *int96PlainDecoder) decodeInt96(dst []Int96) error {
 	return nil
 }
 
+func (d *int96PlainDecoder) skip(n int) error {
+	if len(d.data) < 12*n {
+		return errNED
+	}
+	d.data = d.data[12*n:]
+	return nil
+}
+
 type int96DictDecoder struct {
 	dictDecoder
 
@@ -78,3 +87,94 @@ func (d *int96DictDecoder) decodeInt96(dst []Int96) error {
 	}
 	return nil
 }
+
+type int96Encoder interface {
+	encodeInt96(values []Int96) error
+}
+
+func encodeInt96(e int96Encoder, values interface{}) error {
+	switch values := values.(type) {
+	case []Int96:
+		return e.encodeInt96(values)
+	case []interface{}:
+		b := make([]Int96, len(values))
+		for i, v := range values {
+			var ok bool
+			if b[i], ok = v.(Int96); !ok {
+				return fmt.Errorf("int96: cannot encode value of type %T", v)
+			}
+		}
+		return e.encodeInt96(b)
+	default:
+		panic("invalid argument")
+	}
+}
+
+type int96PlainEncoder struct {
+	data []byte
+}
+
+func (e *int96PlainEncoder) encode(values interface{}) error {
+	return encodeInt96(e, values)
+}
+
+func (e *int96PlainEncoder) encodeInt96(values []Int96) error {
+	for i := range values {
+		e.data = append(e.data, values[i][:]...)
+	}
+	return nil
+}
+
+func (e *int96PlainEncoder) size() int {
+	return len(e.data)
+}
+
+func (e *int96PlainEncoder) flush() []byte {
+	data := e.data
+	e.data = e.data[:0]
+	return data
+}
+
+type int96DictEncoder struct {
+	dictEncoder
+
+	index   map[Int96]int32
+	entries []Int96
+}
+
+func newInt96DictEncoder() *int96DictEncoder {
+	return &int96DictEncoder{
+		dictEncoder: dictEncoder{dict: &int96PlainEncoder{}},
+		index:       make(map[Int96]int32),
+	}
+}
+
+func (e *int96DictEncoder) encode(values interface{}) error {
+	return encodeInt96(e, values)
+}
+
+func (e *int96DictEncoder) encodeInt96(values []Int96) error {
+	n := len(e.entries)
+	for _, v := range values {
+		k, ok := e.index[v]
+		if !ok {
+			k = int32(len(e.entries))
+			e.index[v] = k
+			e.entries = append(e.entries, v)
+		}
+		e.keys = append(e.keys, k)
+	}
+	e.raw += 12 * len(values)
+	e.count = len(e.entries)
+	return e.dict.encode(e.entries[n:])
+}
+
+func (e *int96DictEncoder) fallBackTo(enc valuesEncoder) error {
+	values := make([]Int96, len(e.keys))
+	for i, k := range e.keys {
+		values[i] = e.entries[k]
+	}
+	e.keys = e.keys[:0]
+	e.raw = 0
+	return enc.encode(values)
+}
@@ -30,3 +30,15 @@ func varInt32(bytes []byte) (int32, int) {
 	}
 	return int32(uv), n
 }
+
+func appendVarInt(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func appendZigZagVarInt(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
@@ -0,0 +1,727 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"reflect"
+
+	"github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+// number of rows added to a page between checks if the page is full
+const writeBatchRows = 128
+
+// ColumnChunkWriter allows to write data to a single column chunk of a parquet
+// file.
+//
+// Written values are encoded and split into pages in memory. The column chunk
+// is written to the file when Close is called. This allows to have multiple
+// ColumnChunkWriters open for the same row group at the same time.
+type ColumnChunkWriter struct {
+	col Column
+
+	fw       *FileWriter
+	rowGroup *parquetformat.RowGroup
+
+	pageSize     int
+	pageRowCount int
+
+	err       error
+	closed    bool
+	chunkMeta *parquetformat.ColumnMetaData
+	pages     bytes.Buffer
+	numRows   int64
+
+	// locations of data pages relative to the beginning of the column chunk
+	pageLocations []*parquetformat.PageLocation
+
+	valuesEncoding parquetformat.Encoding
+	valuesEncoder  valuesEncoder
+	dEncoder       *rleEncoder
+	rEncoder       *rleEncoder
+	pageNumValues  int
+	pageNumRows    int
+	pageBuf        bytes.Buffer
+
+	// dictionary encoding (dictEncoder is nil if the dictionary is not used)
+	dictEncoder      dictValuesEncoder
+	dictPageSize     int
+	dictDataPages    int // number of data pages encoded with the dictionary
+	fallbackEncoding parquetformat.Encoding
+
+	// DELTA_BINARY_PACKED encoding
+	deltaBlockSize  int
+	deltaMiniBlocks int
+
+	// statistics of the current page and of the column chunk, columnIndex
+	// is nil if it cannot be written for the column chunk
+	truncateLength int
+	pageStats      *statsAccumulator
+	chunkStats     *statsAccumulator
+	columnIndex    *parquetformat.ColumnIndex
+}
+
+func newColumnChunkWriter(fw *FileWriter, col Column) *ColumnChunkWriter {
+	cw := &ColumnChunkWriter{
+		col:          col,
+		fw:           fw,
+		rowGroup:     fw.rowGroup,
+		pageSize:     fw.PageSize,
+		pageRowCount: fw.PageRowCount,
+		dictPageSize: fw.DictionaryPageSize,
+
+		deltaBlockSize:  DefaultDeltaBlockSize,
+		deltaMiniBlocks: DefaultDeltaMiniBlocks,
+
+		truncateLength: fw.StatisticsTruncateLength,
+		pageStats:      newStatsAccumulator(col),
+		chunkStats:     newStatsAccumulator(col),
+		chunkMeta: &parquetformat.ColumnMetaData{
+			Type:         col.Type(),
+			Encodings:    []parquetformat.Encoding{},
+			PathInSchema: col.Path(),
+			Codec:        fw.Compression,
+		},
+	}
+	if cw.pageSize <= 0 {
+		cw.pageSize = DefaultPageSize
+	}
+	if cw.pageRowCount <= 0 {
+		cw.pageRowCount = DefaultPageRowCount
+	}
+	if cw.dictPageSize <= 0 {
+		cw.dictPageSize = DefaultDictionaryPageSize
+	}
+	if cw.truncateLength == 0 {
+		cw.truncateLength = DefaultStatisticsTruncateLength
+	}
+	if cw.pageStats.compare != nil {
+		cw.columnIndex = &parquetformat.ColumnIndex{
+			NullPages:  []bool{},
+			MinValues:  [][]byte{},
+			MaxValues:  [][]byte{},
+			NullCounts: []int64{},
+		}
+	}
+
+	if col.maxD > 0 {
+		cw.dEncoder = newRLEEncoder(bits.Len16(col.maxD))
+	}
+	if col.maxR > 0 {
+		cw.rEncoder = newRLEEncoder(bits.Len16(col.maxR))
+	}
+
+	cw.valuesEncoding = parquetformat.Encoding_PLAIN
+	cw.fallbackEncoding = parquetformat.Encoding_PLAIN
+	cw.valuesEncoder, cw.err = cw.newValuesEncoder(cw.valuesEncoding)
+	if cw.err == nil {
+		cw.err = checkCompressionCodec(cw.chunkMeta.Codec)
+	}
+
+	return cw
+}
+
+// SetEncoding sets the encoding of values. It must be called before any values
+// are written (usually right after the ColumnChunkWriter is created).
+//
+// With RLE_DICTIONARY encoding values of data pages are stored as keys of a
+// dictionary written to the dictionary page of the column chunk. The writer
+// falls back to the fallback encoding (see SetFallbackEncoding) for the
+// current and all subsequent pages when the size of the dictionary exceeds
+// FileWriter.DictionaryPageSize or when the first page encoded with the
+// dictionary is not smaller than PLAIN encoded values.
+func (cw *ColumnChunkWriter) SetEncoding(encoding parquetformat.Encoding) error {
+	if err := cw.checkNotStarted(); err != nil {
+		return err
+	}
+	var (
+		e   valuesEncoder
+		d   dictValuesEncoder
+		err error
+	)
+	if encoding == parquetformat.Encoding_RLE_DICTIONARY {
+		d, err = cw.newDictValuesEncoder()
+		e = d
+	} else {
+		e, err = cw.newValuesEncoder(encoding)
+	}
+	if err != nil {
+		return fmt.Errorf("parquet: column %s: %s", cw.col, err)
+	}
+	cw.valuesEncoding = encoding
+	cw.valuesEncoder = e
+	cw.dictEncoder = d
+	return nil
+}
+
+// SetFallbackEncoding sets the encoding of values used instead of
+// RLE_DICTIONARY when dictionary encoding is not efficient (PLAIN by default).
+// It must be called before any values are written.
+func (cw *ColumnChunkWriter) SetFallbackEncoding(encoding parquetformat.Encoding) error {
+	if err := cw.checkNotStarted(); err != nil {
+		return err
+	}
+	if _, err := cw.newValuesEncoder(encoding); err != nil {
+		return fmt.Errorf("parquet: column %s: %s", cw.col, err)
+	}
+	cw.fallbackEncoding = encoding
+	return nil
+}
+
+// SetDeltaBlockSize sets the number of values in a block and the number of
+// miniblocks in a block of DELTA_BINARY_PACKED encoded values, including
+// lengths of DELTA_LENGTH_BYTE_ARRAY and DELTA_BYTE_ARRAY encoded values
+// (DefaultDeltaBlockSize and DefaultDeltaMiniBlocks by default). blockSize
+// must be a multiple of 128 and the number of values in a miniblock must be
+// a multiple of 32. It must be called before any values are written.
+func (cw *ColumnChunkWriter) SetDeltaBlockSize(blockSize int, numMiniBlocks int) error {
+	if err := cw.checkNotStarted(); err != nil {
+		return err
+	}
+	if blockSize <= 0 || blockSize%128 != 0 {
+		return fmt.Errorf("parquet: invalid delta block size %d", blockSize)
+	}
+	if numMiniBlocks <= 0 || blockSize%numMiniBlocks != 0 || (blockSize/numMiniBlocks)%32 != 0 {
+		return fmt.Errorf("parquet: invalid number of miniblocks %d in a delta block of %d values", numMiniBlocks, blockSize)
+	}
+	cw.deltaBlockSize = blockSize
+	cw.deltaMiniBlocks = numMiniBlocks
+	if cw.dictEncoder == nil {
+		// re-create the encoder to use the new block size
+		e, err := cw.newValuesEncoder(cw.valuesEncoding)
+		if err != nil {
+			return err
+		}
+		cw.valuesEncoder = e
+	}
+	return nil
+}
+
+// SetCompression sets the compression codec of data pages. It must be called
+// before any values are written.
+func (cw *ColumnChunkWriter) SetCompression(codec parquetformat.CompressionCodec) error {
+	if err := cw.checkNotStarted(); err != nil {
+		return err
+	}
+	if err := checkCompressionCodec(codec); err != nil {
+		return fmt.Errorf("parquet: column %s: %s", cw.col, err)
+	}
+	cw.chunkMeta.Codec = codec
+	return nil
+}
+
+func (cw *ColumnChunkWriter) checkNotStarted() error {
+	if cw.closed {
+		return errors.New("parquet: column chunk writer is closed")
+	}
+	if cw.err != nil {
+		return cw.err
+	}
+	if cw.pages.Len() > 0 || cw.pageNumValues > 0 {
+		return fmt.Errorf("parquet: column %s: values have already been written", cw.col)
+	}
+	return nil
+}
+
+func (cw *ColumnChunkWriter) newValuesEncoder(encoding parquetformat.Encoding) (valuesEncoder, error) {
+	typ := cw.col.Type()
+	switch typ {
+	case parquetformat.Type_BOOLEAN:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &booleanPlainEncoder{}, nil
+		case parquetformat.Encoding_RLE:
+			return newBooleanRLEEncoder(), nil
+		}
+
+	case parquetformat.Type_BYTE_ARRAY:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &byteArrayPlainEncoder{}, nil
+		case parquetformat.Encoding_DELTA_LENGTH_BYTE_ARRAY:
+			return newByteArrayDeltaLengthEncoder(cw.deltaBlockSize, cw.deltaMiniBlocks), nil
+		case parquetformat.Encoding_DELTA_BYTE_ARRAY:
+			return newByteArrayDeltaEncoder(0, cw.deltaBlockSize, cw.deltaMiniBlocks), nil
+		}
+
+	case parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &byteArrayPlainEncoder{length: int(*cw.col.schemaElement.TypeLength)}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newByteArrayByteStreamSplitEncoder(int(*cw.col.schemaElement.TypeLength)), nil
+		case parquetformat.Encoding_DELTA_BYTE_ARRAY:
+			return newByteArrayDeltaEncoder(int(*cw.col.schemaElement.TypeLength), cw.deltaBlockSize, cw.deltaMiniBlocks), nil
+		}
+
+	case parquetformat.Type_FLOAT:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &floatPlainEncoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newFloatByteStreamSplitEncoder(), nil
+		}
+
+	case parquetformat.Type_DOUBLE:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &doublePlainEncoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newDoubleByteStreamSplitEncoder(), nil
+		}
+
+	case parquetformat.Type_INT32:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &int32PlainEncoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newInt32ByteStreamSplitEncoder(), nil
+		case parquetformat.Encoding_DELTA_BINARY_PACKED:
+			return newInt32DeltaBinaryPackedEncoder(cw.deltaBlockSize, cw.deltaMiniBlocks), nil
+		}
+
+	case parquetformat.Type_INT64:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &int64PlainEncoder{}, nil
+		case parquetformat.Encoding_BYTE_STREAM_SPLIT:
+			return newInt64ByteStreamSplitEncoder(), nil
+		case parquetformat.Encoding_DELTA_BINARY_PACKED:
+			return newInt64DeltaBinaryPackedEncoder(cw.deltaBlockSize, cw.deltaMiniBlocks), nil
+		}
+
+	case parquetformat.Type_INT96:
+		switch encoding {
+		case parquetformat.Encoding_PLAIN:
+			return &int96PlainEncoder{}, nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", typ)
+	}
+
+	return nil, fmt.Errorf("unsupported encoding %s for %s type", encoding, typ)
+}
+
+func (cw *ColumnChunkWriter) newDictValuesEncoder() (dictValuesEncoder, error) {
+	typ := cw.col.Type()
+	switch typ {
+	case parquetformat.Type_BYTE_ARRAY:
+		return newByteArrayDictEncoder(0), nil
+	case parquetformat.Type_FIXED_LEN_BYTE_ARRAY:
+		return newByteArrayDictEncoder(int(*cw.col.schemaElement.TypeLength)), nil
+	case parquetformat.Type_FLOAT:
+		return newFloatDictEncoder(), nil
+	case parquetformat.Type_DOUBLE:
+		return newDoubleDictEncoder(), nil
+	case parquetformat.Type_INT32:
+		return newInt32DictEncoder(), nil
+	case parquetformat.Type_INT64:
+		return newInt64DictEncoder(), nil
+	case parquetformat.Type_INT96:
+		return newInt96DictEncoder(), nil
+	}
+	return nil, fmt.Errorf("unsupported encoding %s for %s type", parquetformat.Encoding_RLE_DICTIONARY, typ)
+}
+
+// usesDict returns true if values of the current page are encoded with the
+// dictionary.
+func (cw *ColumnChunkWriter) usesDict() bool {
+	return cw.dictEncoder != nil && cw.valuesEncoding == parquetformat.Encoding_RLE_DICTIONARY
+}
+
+// fallBack switches from dictionary encoding to the fallback encoding. Values
+// of the current page are re-encoded. The dictionary is kept if it is used by
+// the already written pages.
+func (cw *ColumnChunkWriter) fallBack() error {
+	e, err := cw.newValuesEncoder(cw.fallbackEncoding)
+	if err != nil {
+		return err
+	}
+	if err = cw.dictEncoder.fallBackTo(e); err != nil {
+		return fmt.Errorf("parquet: failed to encode values of column %s: %s", cw.col, err)
+	}
+	cw.valuesEncoding = cw.fallbackEncoding
+	cw.valuesEncoder = e
+	if cw.dictDataPages == 0 {
+		cw.dictEncoder = nil
+	}
+	return nil
+}
+
+// Write writes values along with their definition and repetition levels from
+// dLevels and rLevels respectively. Arguments have the same form as the
+// arguments of ColumnChunkReader.Read, so that data read from one column chunk
+// can be written to another one as is.
+//
+// len(dLevels) must be equal to len(rLevels). values must contain only
+// non-null values and must have at least as many elements as there are
+// definition levels equal to the column's max definition level; any other
+// elements are ignored.
+//
+// values must be a slice of interface{} or type that corresponds to the column
+// type (such as []int32 for INT32 column or [][]byte for BYTE_ARRAY column).
+//
+// A row (a record in a column with repeated fields) can be split between
+// multiple calls to Write. Data pages are only finished at row boundaries.
+func (cw *ColumnChunkWriter) Write(values interface{}, dLevels []uint16, rLevels []uint16) error {
+	if cw.closed {
+		return errors.New("parquet: column chunk writer is closed")
+	}
+	if cw.err != nil {
+		return cw.err
+	}
+
+	nn, err := checkLevels(cw.col, dLevels, rLevels)
+	if err != nil {
+		return err
+	}
+	if len(rLevels) > 0 && rLevels[0] != 0 && cw.chunkMeta.NumValues == 0 && cw.pageNumValues == 0 {
+		return fmt.Errorf("parquet: the first repetition level must be 0 for column %s", cw.col)
+	}
+	// values are not used (and can be nil) if all values are null
+	var rv reflect.Value
+	if nn > 0 {
+		rv = reflect.ValueOf(values)
+		if rv.Kind() != reflect.Slice {
+			return fmt.Errorf("parquet: values of column %s must be a slice, got %T", cw.col, values)
+		}
+		if rv.Len() < nn {
+			return fmt.Errorf("parquet: not enough values for column %s: %d, want %d", cw.col, rv.Len(), nn)
+		}
+	}
+
+	vi := 0
+	for len(dLevels) > 0 {
+		if rLevels[0] == 0 && cw.pageFull() {
+			if err = cw.flushPage(); err != nil {
+				cw.err = err
+				return err
+			}
+		}
+
+		n, rows, bnn := cw.nextBatch(dLevels, rLevels)
+		if cw.dEncoder != nil {
+			cw.dEncoder.encodeLevels(dLevels[:n])
+		}
+		if cw.rEncoder != nil {
+			cw.rEncoder.encodeLevels(rLevels[:n])
+		}
+		var batch interface{}
+		if bnn > 0 {
+			batch = rv.Slice(vi, vi+bnn).Interface()
+			if err = cw.valuesEncoder.encode(batch); err != nil {
+				cw.err = fmt.Errorf("parquet: failed to encode values of column %s: %s", cw.col, err)
+				return cw.err
+			}
+			vi += bnn
+			if cw.usesDict() && cw.dictEncoder.dictSize() > cw.dictPageSize {
+				if err = cw.fallBack(); err != nil {
+					cw.err = err
+					return err
+				}
+			}
+		}
+		cw.pageStats.add(batch, n-bnn)
+		cw.pageNumValues += n
+		cw.pageNumRows += rows
+
+		dLevels = dLevels[n:]
+		rLevels = rLevels[n:]
+	}
+
+	return nil
+}
+
+// nextBatch returns the number of levels (n), rows and non-null values (nn)
+// from the beginning of dLevels and rLevels that should be added to the
+// current page.
+func (cw *ColumnChunkWriter) nextBatch(dLevels []uint16, rLevels []uint16) (n int, rows int, nn int) {
+	maxRows := cw.pageRowCount - cw.pageNumRows
+	if maxRows > writeBatchRows {
+		maxRows = writeBatchRows
+	}
+	for ; n < len(dLevels); n++ {
+		if rLevels[n] == 0 {
+			if rows == maxRows {
+				break
+			}
+			rows++
+		}
+		if dLevels[n] == cw.col.maxD {
+			nn++
+		}
+	}
+	return n, rows, nn
+}
+
+func (cw *ColumnChunkWriter) pageFull() bool {
+	if cw.pageNumRows >= cw.pageRowCount {
+		return true
+	}
+	return cw.pageSizeEstimate() >= cw.pageSize
+}
+
+// pageSizeEstimate returns the approximate size of encoded data of the
+// current page.
+func (cw *ColumnChunkWriter) pageSizeEstimate() int {
+	size := cw.valuesEncoder.size()
+	if cw.dEncoder != nil {
+		size += cw.dEncoder.size()
+	}
+	if cw.rEncoder != nil {
+		size += cw.rEncoder.size()
+	}
+	return size
+}
+
+// bufferedSize returns the approximate size of the column chunk if it was
+// written now.
+func (cw *ColumnChunkWriter) bufferedSize() int64 {
+	size := cw.pages.Len() + cw.pageSizeEstimate()
+	if cw.dictEncoder != nil {
+		size += cw.dictEncoder.dictSize()
+	}
+	return int64(size)
+}
+
+// FlushPage finishes the current data page. Usually it is not necessary to
+// call this method as pages are flushed automatically when they are full.
+func (cw *ColumnChunkWriter) FlushPage() error {
+	if cw.closed {
+		return errors.New("parquet: column chunk writer is closed")
+	}
+	if cw.err != nil {
+		return cw.err
+	}
+	if cw.pageNumValues == 0 {
+		return nil
+	}
+	cw.err = cw.flushPage()
+	return cw.err
+}
+
+func (cw *ColumnChunkWriter) flushPage() error {
+	if cw.usesDict() && cw.dictDataPages == 0 {
+		// the same check as in parquet-mr: dictionary encoding is used only
+		// if the first page and the dictionary are smaller than PLAIN
+		// encoded values
+		d := cw.dictEncoder
+		if d.size()+d.dictSize() >= d.rawSize() {
+			if err := cw.fallBack(); err != nil {
+				return err
+			}
+		}
+	}
+	if cw.usesDict() {
+		cw.dictDataPages++
+	}
+
+	page := &cw.pageBuf
+	page.Reset()
+	if cw.rEncoder != nil {
+		writeLevels(page, cw.rEncoder.flush())
+	}
+	if cw.dEncoder != nil {
+		writeLevels(page, cw.dEncoder.flush())
+	}
+	if cw.rEncoder != nil || cw.dEncoder != nil {
+		cw.addEncoding(parquetformat.Encoding_RLE)
+	}
+	page.Write(cw.valuesEncoder.flush())
+	cw.addEncoding(cw.valuesEncoding)
+
+	ph := &parquetformat.PageHeader{
+		Type: parquetformat.PageType_DATA_PAGE,
+		DataPageHeader: &parquetformat.DataPageHeader{
+			NumValues:               int32(cw.pageNumValues),
+			Encoding:                cw.valuesEncoding,
+			DefinitionLevelEncoding: parquetformat.Encoding_RLE,
+			RepetitionLevelEncoding: parquetformat.Encoding_RLE,
+			Statistics:              cw.pageStats.statistics(cw.truncateLength),
+		},
+	}
+	loc := &parquetformat.PageLocation{
+		Offset:        int64(cw.pages.Len()),
+		FirstRowIndex: cw.numRows,
+	}
+	if err := cw.writePage(&cw.pages, ph, page.Bytes()); err != nil {
+		return err
+	}
+	cw.addEncodingStats(parquetformat.PageType_DATA_PAGE, cw.valuesEncoding)
+	loc.CompressedPageSize = int32(int64(cw.pages.Len()) - loc.Offset)
+	cw.pageLocations = append(cw.pageLocations, loc)
+	cw.addColumnIndexEntry(ph.DataPageHeader.Statistics)
+	cw.chunkStats.mergeStats(cw.pageStats)
+	cw.pageStats.reset()
+
+	cw.chunkMeta.NumValues += int64(cw.pageNumValues)
+	cw.numRows += int64(cw.pageNumRows)
+	cw.pageNumValues = 0
+	cw.pageNumRows = 0
+	return nil
+}
+
+// addColumnIndexEntry adds statistics of the current page to the column
+// index. The column index is dropped if min and max values of a page with
+// non-null values are unknown (e.g. all values are NaN).
+func (cw *ColumnChunkWriter) addColumnIndexEntry(stats *parquetformat.Statistics) {
+	ci := cw.columnIndex
+	if ci == nil {
+		return
+	}
+	null := cw.pageStats.numValues == 0
+	if !null && stats.MinValue == nil {
+		cw.columnIndex = nil
+		return
+	}
+	ci.NullPages = append(ci.NullPages, null)
+	ci.MinValues = append(ci.MinValues, append([]byte{}, stats.MinValue...))
+	ci.MaxValues = append(ci.MaxValues, append([]byte{}, stats.MaxValue...))
+	ci.NullCounts = append(ci.NullCounts, *stats.NullCount)
+}
+
+// writeDictPage writes the dictionary page before all data pages of the
+// column chunk.
+func (cw *ColumnChunkWriter) writeDictPage() error {
+	ph := &parquetformat.PageHeader{
+		Type: parquetformat.PageType_DICTIONARY_PAGE,
+		DictionaryPageHeader: &parquetformat.DictionaryPageHeader{
+			NumValues: int32(cw.dictEncoder.numEntries()),
+			Encoding:  parquetformat.Encoding_PLAIN,
+		},
+	}
+	var buf bytes.Buffer
+	if err := cw.writePage(&buf, ph, cw.dictEncoder.flushDict()); err != nil {
+		return err
+	}
+	cw.addEncoding(parquetformat.Encoding_PLAIN)
+	cw.addEncodingStats(parquetformat.PageType_DICTIONARY_PAGE, parquetformat.Encoding_PLAIN)
+
+	size := int64(buf.Len())
+	buf.Write(cw.pages.Bytes())
+	cw.pages.Reset()
+	cw.pages.Write(buf.Bytes())
+	for _, loc := range cw.pageLocations {
+		loc.Offset += size
+	}
+	var dictOffset int64
+	cw.chunkMeta.DictionaryPageOffset = &dictOffset
+	cw.chunkMeta.DataPageOffset = size
+	return nil
+}
+
+// writePage appends a page with header ph and content data to w (the
+// buffered pages of the column chunk or the dictionary page).
+func (cw *ColumnChunkWriter) writePage(w *bytes.Buffer, ph *parquetformat.PageHeader, data []byte) error {
+	ph.UncompressedPageSize = int32(len(data))
+	data, err := compressPageData(cw.chunkMeta.Codec, data)
+	if err != nil {
+		return err
+	}
+	ph.CompressedPageSize = int32(len(data))
+
+	n := w.Len()
+	if err := ph.Write(w); err != nil {
+		return err
+	}
+	headerSize := int64(w.Len() - n)
+	w.Write(data)
+
+	cw.chunkMeta.TotalUncompressedSize += headerSize + int64(ph.UncompressedPageSize)
+	cw.chunkMeta.TotalCompressedSize += headerSize + int64(ph.CompressedPageSize)
+	return nil
+}
+
+func (cw *ColumnChunkWriter) addEncoding(encoding parquetformat.Encoding) {
+	for _, e := range cw.chunkMeta.Encodings {
+		if e == encoding {
+			return
+		}
+	}
+	cw.chunkMeta.Encodings = append(cw.chunkMeta.Encodings, encoding)
+}
+
+// addEncodingStats counts a page of type pageType encoded with encoding.
+func (cw *ColumnChunkWriter) addEncodingStats(pageType parquetformat.PageType, encoding parquetformat.Encoding) {
+	for _, s := range cw.chunkMeta.EncodingStats {
+		if s.PageType == pageType && s.Encoding == encoding {
+			s.Count++
+			return
+		}
+	}
+	cw.chunkMeta.EncodingStats = append(cw.chunkMeta.EncodingStats, &parquetformat.PageEncodingStats{
+		PageType: pageType,
+		Encoding: encoding,
+		Count:    1,
+	})
+}
+
+// Close flushes all buffered data and writes the column chunk to the file.
+//
+// Close must be called before the next row group is started.
+func (cw *ColumnChunkWriter) Close() error {
+	if cw.closed {
+		return errors.New("parquet: column chunk writer is already closed")
+	}
+	cw.closed = true
+	if cw.err != nil {
+		return cw.err
+	}
+
+	// a column chunk should have at least one page
+	if cw.pageNumValues > 0 || cw.pages.Len() == 0 {
+		if err := cw.flushPage(); err != nil {
+			return err
+		}
+	}
+	if cw.dictEncoder != nil && cw.dictDataPages > 0 {
+		if err := cw.writeDictPage(); err != nil {
+			return err
+		}
+	}
+	cw.chunkMeta.Statistics = cw.chunkStats.statistics(cw.truncateLength)
+	if cw.columnIndex != nil {
+		cw.columnIndex.BoundaryOrder = boundaryOrder(cw.columnIndex, cw.col.Type(), cw.chunkStats.compare)
+	}
+
+	return cw.fw.addColumnChunk(cw)
+}
+
+// ColumnMetaData returns metadata of the column chunk. The returned object
+// is complete only after Close.
+func (cw *ColumnChunkWriter) ColumnMetaData() *parquetformat.ColumnMetaData {
+	return cw.chunkMeta
+}
+
+// checkLevels validates definition and repetition levels for col and returns
+// the number of non-null values.
+func checkLevels(col Column, dLevels []uint16, rLevels []uint16) (nn int, err error) {
+	if len(dLevels) != len(rLevels) {
+		return 0, fmt.Errorf("parquet: len(dLevels) = %d is not the same as len(rLevels) = %d",
+			len(dLevels), len(rLevels))
+	}
+	for i, d := range dLevels {
+		if d > col.maxD {
+			return 0, fmt.Errorf("parquet: invalid definition level %d for column %s", d, col)
+		}
+		if r := rLevels[i]; r > col.maxR {
+			return 0, fmt.Errorf("parquet: invalid repetition level %d for column %s", r, col)
+		}
+		if d == col.maxD {
+			nn++
+		}
+	}
+	return nn, nil
+}
+
+// writeLevels writes encoded levels prefixed with their length
+func writeLevels(buf *bytes.Buffer, data []byte) {
+	var l [4]byte
+	binary.LittleEndian.PutUint32(l[:], uint32(len(data)))
+	buf.Write(l[:])
+	buf.Write(data)
+}
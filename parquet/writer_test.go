@@ -0,0 +1,684 @@
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	pf "github.com/kostya-sh/parquet-go/parquetformat"
+)
+
+func countPages(f *File, col Column, rg int) (pages []int32, err error) {
+	cr, err := f.NewReader(col, rg)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		pages = append(pages, cr.PageHeader().DataPageHeader.NumValues)
+		err = cr.SkipPage()
+		if err == EndOfChunk {
+			return pages, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func TestColumnChunkWriterPages(t *testing.T) {
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, mustCreateSchema(writerTestMeta))
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 2
+	writeTestFile(t, fw, writerTestData)
+
+	checkTestFile(t, buf.Bytes(), writerTestData)
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+
+	// b (repeated): [true, false], [], [true] in the first row group
+	pages, err := countPages(f, f.Schema.Columns()[2], 0)
+	if err != nil {
+		t.Fatalf("failed to read pages: %s", err)
+	}
+	if want := []int32{3, 1}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("got pages with %v values, want %v", pages, want)
+	}
+
+	for rg := range f.MetaData.RowGroups {
+		for _, col := range f.Schema.Columns() {
+			meta := f.MetaData.RowGroups[rg].Columns[col.Index()].MetaData
+			if want := int64(len(writerTestData[rg][col.Index()])); meta.NumValues != want {
+				t.Errorf("row group %d, column %s: NumValues = %d, want %d", rg, col, meta.NumValues, want)
+			}
+			if !reflect.DeepEqual(meta.PathInSchema, col.Path()) {
+				t.Errorf("row group %d, column %s: PathInSchema = %v", rg, col, meta.PathInSchema)
+			}
+		}
+	}
+}
+
+func TestColumnChunkWriterPageSize(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{
+			Name:        "Test",
+			NumChildren: int32Ptr(1),
+		},
+		&pf.SchemaElement{
+			Name:           "i32",
+			Type:           typeInt32,
+			RepetitionType: frtRequired,
+		},
+	))
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageSize = 1
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	cw, err := fw.NewWriter(schema.Columns()[0])
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	// page size is checked between batches of rows, write one row at a time
+	// to get a page per row
+	for i := 0; i < 3; i++ {
+		if err = cw.Write([]int32{int32(i)}, []uint16{0}, []uint16{0}); err != nil {
+			t.Fatalf("failed to write: %s", err)
+		}
+	}
+	if err = cw.Close(); err != nil {
+		t.Fatalf("failed to close column chunk writer: %s", err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read written file: %s", err)
+	}
+	pages, err := countPages(f, f.Schema.Columns()[0], 0)
+	if err != nil {
+		t.Fatalf("failed to read pages: %s", err)
+	}
+	if want := []int32{1, 1, 1}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("got pages with %v values, want %v", pages, want)
+	}
+	cells, err := readCells(f, f.Schema.Columns()[0], 0)
+	if err != nil {
+		t.Fatalf("failed to read column: %s", err)
+	}
+	if want := []cell{{0, 0, int32(0)}, {0, 0, int32(1)}, {0, 0, int32(2)}}; !reflect.DeepEqual(cells, want) {
+		t.Errorf("got %v, want %v", cells, want)
+	}
+}
+
+// copyFile copies all data from f using ColumnChunkReader and
+// ColumnChunkWriter.
+func copyFile(f *File, fw *FileWriter, batch int) error {
+	values := make([]interface{}, batch)
+	dLevels := make([]uint16, batch)
+	rLevels := make([]uint16, batch)
+	for rg := range f.MetaData.RowGroups {
+		if err := fw.StartRowGroup(); err != nil {
+			return err
+		}
+		for _, col := range f.Schema.Columns() {
+			cr, err := f.NewReader(col, rg)
+			if err != nil {
+				return err
+			}
+			cw, err := fw.NewWriter(col)
+			if err != nil {
+				return err
+			}
+			for {
+				n, err := cr.Read(values, dLevels, rLevels)
+				if err == EndOfChunk {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				if err = cw.Write(values, dLevels[:n], rLevels[:n]); err != nil {
+					return err
+				}
+			}
+			if err = cw.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	return fw.Close()
+}
+
+func TestCopyFile(t *testing.T) {
+	testFiles := []string{
+		"Booleans",
+		"ByteArrays",
+		"ByteArrays_V2_SNAPPY",
+	}
+
+	for _, fn := range testFiles {
+		f, err := OpenFile("testdata/" + fn + ".parquet")
+		if err != nil {
+			t.Errorf("failed to open %s: %s", fn, err)
+			continue
+		}
+
+		buf := new(bytes.Buffer)
+		fw, err := NewFileWriter(buf, f.Schema)
+		if err != nil {
+			t.Fatalf("failed to create file writer: %s", err)
+		}
+		fw.PageRowCount = 2
+		if err = copyFile(f, fw, 3); err != nil {
+			t.Errorf("%s: copy failed: %s", fn, err)
+			f.Close()
+			continue
+		}
+
+		copied, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Errorf("%s: failed to read copied file: %s", fn, err)
+			f.Close()
+			continue
+		}
+		for rg := range f.MetaData.RowGroups {
+			for _, col := range f.Schema.Columns() {
+				want, err := readCells(f, col, rg)
+				if err != nil {
+					t.Errorf("%s: failed to read column %s: %s", fn, col, err)
+					continue
+				}
+				got, err := readCells(copied, col, rg)
+				if err != nil {
+					t.Errorf("%s: failed to read copied column %s: %s", fn, col, err)
+					continue
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: column %s: got %v, want %v", fn, col, got, want)
+				}
+			}
+		}
+		f.Close()
+	}
+}
+
+// writeDictTestColumn writes cells to column col of the current row group of
+// fw using RLE_DICTIONARY encoding with the given fallback encoding.
+func writeDictTestColumn(t *testing.T, fw *FileWriter, col Column, fallback pf.Encoding, cells []cell) {
+	t.Helper()
+	cw, err := fw.NewWriter(col)
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	if err = cw.SetEncoding(pf.Encoding_RLE_DICTIONARY); err != nil {
+		t.Fatalf("failed to set encoding: %s", err)
+	}
+	if err = cw.SetFallbackEncoding(fallback); err != nil {
+		t.Fatalf("failed to set fallback encoding: %s", err)
+	}
+	var values []interface{}
+	var dLevels, rLevels []uint16
+	for _, c := range cells {
+		if c.v != nil {
+			values = append(values, c.v)
+		}
+		dLevels = append(dLevels, c.d)
+		rLevels = append(rLevels, c.r)
+	}
+	// written in batches to check the dictionary size between them
+	for i := 0; i < len(cells); i += 7 {
+		j := i + 7
+		if j > len(cells) {
+			j = len(cells)
+		}
+		nn, _ := checkLevels(col, dLevels[i:j], rLevels[i:j])
+		if err = cw.Write(values[:nn], dLevels[i:j], rLevels[i:j]); err != nil {
+			t.Fatalf("failed to write column %s: %s", col, err)
+		}
+		values = values[nn:]
+	}
+	if err = cw.Close(); err != nil {
+		t.Fatalf("failed to close column chunk writer: %s", err)
+	}
+}
+
+func encodingStats(meta *pf.ColumnMetaData) map[string]int32 {
+	stats := make(map[string]int32)
+	for _, s := range meta.EncodingStats {
+		stats[fmt.Sprintf("%s/%s", s.PageType, s.Encoding)] += s.Count
+	}
+	return stats
+}
+
+func TestWriteDictionary(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(7)},
+		&pf.SchemaElement{Name: "i32", Type: typeInt32, RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "i64", Type: typeInt64, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "f", Type: typeFloat, RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "d", Type: typeDouble, RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "s", Type: typeByteArray, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "fixed", Type: typeFixedLenByteArray, TypeLength: int32Ptr(3), RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "i96", Type: typeInt96, RepetitionType: frtRequired},
+	))
+	data := make([][]cell, len(schema.Columns()))
+	for i := 0; i < 100; i++ {
+		k := i % 3
+		data[0] = append(data[0], cell{0, 0, int32(k)})
+		if i%5 == 0 {
+			data[1] = append(data[1], cell{0, 0, nil})
+			data[4] = append(data[4], cell{0, 0, nil})
+		} else {
+			data[1] = append(data[1], cell{1, 0, int64(k * 1000)})
+			data[4] = append(data[4], cell{1, 0, []byte(fmt.Sprintf("value %d", k))})
+		}
+		data[2] = append(data[2], cell{0, 0, float32(k) + 0.5})
+		data[3] = append(data[3], cell{0, 0, float64(k) - 0.5})
+		data[5] = append(data[5], cell{0, 0, []byte{'a', 'b', byte('0' + k)}})
+		data[6] = append(data[6], cell{0, 0, Int96{byte(k)}})
+	}
+
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 30
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	for _, col := range schema.Columns() {
+		writeDictTestColumn(t, fw, col, pf.Encoding_PLAIN, data[col.Index()])
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	wantStats := map[string]int32{"DATA_PAGE/RLE_DICTIONARY": 4, "DICTIONARY_PAGE/PLAIN": 1}
+	for _, col := range schema.Columns() {
+		meta := f.MetaData.RowGroups[0].Columns[col.Index()].MetaData
+		if meta.DictionaryPageOffset == nil || *meta.DictionaryPageOffset >= meta.DataPageOffset {
+			t.Errorf("column %s: invalid dictionary page offset %v (data page offset %d)",
+				col, meta.DictionaryPageOffset, meta.DataPageOffset)
+		}
+		if !containsEncoding(meta.Encodings, pf.Encoding_RLE_DICTIONARY) || !containsEncoding(meta.Encodings, pf.Encoding_PLAIN) {
+			t.Errorf("column %s: got encodings %v", col, meta.Encodings)
+		}
+		if stats := encodingStats(meta); !reflect.DeepEqual(stats, wantStats) {
+			t.Errorf("column %s: got encoding stats %v, want %v", col, stats, wantStats)
+		}
+		cells, err := readCells(f, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", col, err)
+		}
+		if !reflect.DeepEqual(cells, data[col.Index()]) {
+			t.Errorf("column %s: got %v, want %v", col, cells, data[col.Index()])
+		}
+	}
+
+	// page locations in the offset index must account for the dictionary page
+	cr, err := f.NewReader(schema.Columns()[0], 0)
+	if err != nil {
+		t.Fatalf("failed to create reader: %s", err)
+	}
+	if err = cr.SeekToRow(65); err != nil {
+		t.Fatalf("failed to seek: %s", err)
+	}
+	values := make([]int32, 1)
+	if _, err = cr.Read(values, make([]uint16, 1), make([]uint16, 1)); err != nil || values[0] != 65%3 {
+		t.Errorf("got %v (err = %v) after seek, want [%d]", values, err, 65%3)
+	}
+}
+
+func TestWriteDictionaryFallback(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(2)},
+		&pf.SchemaElement{Name: "s", Type: typeByteArray, RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "i32", Type: typeInt32, RepetitionType: frtRequired},
+	))
+	var sCells, iCells []cell
+	for i := 0; i < 60; i++ {
+		if i < 20 {
+			sCells = append(sCells, cell{0, 0, []byte{byte('a' + i%2)}})
+		} else {
+			sCells = append(sCells, cell{0, 0, []byte(fmt.Sprintf("value-%d", i))})
+		}
+		iCells = append(iCells, cell{0, 0, int32(i * 7)})
+	}
+
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 10
+	fw.DictionaryPageSize = 100
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	// the dictionary grows too large in the third page
+	writeDictTestColumn(t, fw, schema.Columns()[0], pf.Encoding_PLAIN, sCells)
+	// dictionary encoding of the first page is not smaller than PLAIN
+	writeDictTestColumn(t, fw, schema.Columns()[1], pf.Encoding_BYTE_STREAM_SPLIT, iCells)
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	tests := []struct {
+		cells []cell
+		dict  bool
+		stats map[string]int32
+	}{
+		{sCells, true, map[string]int32{"DATA_PAGE/RLE_DICTIONARY": 2, "DATA_PAGE/PLAIN": 4, "DICTIONARY_PAGE/PLAIN": 1}},
+		{iCells, false, map[string]int32{"DATA_PAGE/BYTE_STREAM_SPLIT": 6}},
+	}
+	for i, test := range tests {
+		col := schema.Columns()[i]
+		meta := f.MetaData.RowGroups[0].Columns[i].MetaData
+		if dict := meta.DictionaryPageOffset != nil; dict != test.dict {
+			t.Errorf("column %s: got dictionary page = %t, want %t", col, dict, test.dict)
+		}
+		if dict := containsEncoding(meta.Encodings, pf.Encoding_RLE_DICTIONARY); dict != test.dict {
+			t.Errorf("column %s: got encodings %v", col, meta.Encodings)
+		}
+		if stats := encodingStats(meta); !reflect.DeepEqual(stats, test.stats) {
+			t.Errorf("column %s: got encoding stats %v, want %v", col, stats, test.stats)
+		}
+		cells, err := readCells(f, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", col, err)
+		}
+		if !reflect.DeepEqual(cells, test.cells) {
+			t.Errorf("column %s: got %v, want %v", col, cells, test.cells)
+		}
+	}
+}
+
+func TestWriteDictionaryErrors(t *testing.T) {
+	fw, err := NewFileWriter(new(bytes.Buffer), mustCreateSchema(writerTestMeta))
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	cw, err := fw.NewWriter(fw.Schema.Columns()[2])
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	if err = cw.SetEncoding(pf.Encoding_RLE_DICTIONARY); err == nil {
+		t.Errorf("error expected for dictionary encoding of BOOLEAN column")
+	}
+	cw, err = fw.NewWriter(fw.Schema.Columns()[0])
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	if err = cw.SetFallbackEncoding(pf.Encoding_RLE_DICTIONARY); err == nil {
+		t.Errorf("error expected for RLE_DICTIONARY fallback encoding")
+	}
+}
+
+func TestWriteDeltaBinaryPacked(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(2)},
+		&pf.SchemaElement{Name: "i32", Type: typeInt32, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "i64", Type: typeInt64, RepetitionType: frtRequired},
+	))
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 100
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+
+	data := make([][]cell, 2)
+	for i := 0; i < 300; i++ {
+		if i%10 == 3 {
+			data[0] = append(data[0], cell{0, 0, nil})
+		} else {
+			data[0] = append(data[0], cell{1, 0, int32(i * i)})
+		}
+		data[1] = append(data[1], cell{0, 0, int64(1500000000000 + i*1000 - i%7)})
+	}
+	data[1][150].v = int64(math.MinInt64)
+	data[1][151].v = int64(math.MaxInt64)
+
+	for _, col := range schema.Columns() {
+		cw, err := fw.NewWriter(col)
+		if err != nil {
+			t.Fatalf("failed to create column chunk writer: %s", err)
+		}
+		if err = cw.SetEncoding(pf.Encoding_DELTA_BINARY_PACKED); err != nil {
+			t.Fatalf("failed to set encoding: %s", err)
+		}
+		if err = cw.SetDeltaBlockSize(256, 8); err != nil {
+			t.Fatalf("failed to set delta block size: %s", err)
+		}
+		var values []interface{}
+		var dLevels, rLevels []uint16
+		for _, c := range data[col.Index()] {
+			if c.v != nil {
+				values = append(values, c.v)
+			}
+			dLevels = append(dLevels, c.d)
+			rLevels = append(rLevels, c.r)
+		}
+		if err = cw.Write(values, dLevels, rLevels); err != nil {
+			t.Fatalf("failed to write column %s: %s", col, err)
+		}
+		if err = cw.SetDeltaBlockSize(128, 4); err == nil {
+			t.Errorf("column %s: error expected when changing the block size after values are written", col)
+		}
+		if err = cw.Close(); err != nil {
+			t.Fatalf("failed to close column chunk writer: %s", err)
+		}
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	for _, col := range schema.Columns() {
+		if encodings := f.MetaData.RowGroups[0].Columns[col.Index()].MetaData.Encodings; !containsEncoding(encodings, pf.Encoding_DELTA_BINARY_PACKED) {
+			t.Errorf("column %s: got encodings %v", col, encodings)
+		}
+		cells, err := readCells(f, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", col, err)
+		}
+		if !reflect.DeepEqual(cells, data[col.Index()]) {
+			t.Errorf("column %s: got %v, want %v", col, cells, data[col.Index()])
+		}
+	}
+}
+
+func TestSetDeltaBlockSizeErrors(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(1)},
+		&pf.SchemaElement{Name: "i", Type: typeInt32, RepetitionType: frtRequired},
+	))
+	fw, err := NewFileWriter(new(bytes.Buffer), schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	cw, err := fw.NewWriter(schema.Columns()[0])
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	for _, sizes := range [][2]int{{0, 1}, {100, 1}, {128, 0}, {128, 3}, {128, 8}, {256, -1}} {
+		if err = cw.SetDeltaBlockSize(sizes[0], sizes[1]); err == nil {
+			t.Errorf("SetDeltaBlockSize(%d, %d): error expected", sizes[0], sizes[1])
+		} else {
+			t.Logf("SetDeltaBlockSize(%d, %d): %s", sizes[0], sizes[1], err)
+		}
+	}
+}
+
+func TestWriteDeltaByteArray(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(3)},
+		&pf.SchemaElement{Name: "url", Type: typeByteArray, RepetitionType: frtOptional},
+		&pf.SchemaElement{Name: "key", Type: typeByteArray, RepetitionType: frtRequired},
+		&pf.SchemaElement{Name: "fixed", Type: typeFixedLenByteArray, TypeLength: int32Ptr(6), RepetitionType: frtRequired},
+	))
+	encodings := []pf.Encoding{pf.Encoding_DELTA_LENGTH_BYTE_ARRAY, pf.Encoding_DELTA_BYTE_ARRAY, pf.Encoding_DELTA_BYTE_ARRAY}
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	fw.PageRowCount = 50
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+
+	data := make([][]cell, 3)
+	for i := 0; i < 200; i++ {
+		if i%9 == 4 {
+			data[0] = append(data[0], cell{0, 0, nil})
+		} else {
+			data[0] = append(data[0], cell{1, 0, []byte(fmt.Sprintf("https://example.com/%d/%d", i/10, i))})
+		}
+		data[1] = append(data[1], cell{0, 0, []byte(fmt.Sprintf("key-%05d", i*i))})
+		data[2] = append(data[2], cell{0, 0, []byte(fmt.Sprintf("%06d", i/3))})
+	}
+
+	for _, col := range schema.Columns() {
+		cw, err := fw.NewWriter(col)
+		if err != nil {
+			t.Fatalf("failed to create column chunk writer: %s", err)
+		}
+		if err = cw.SetEncoding(encodings[col.Index()]); err != nil {
+			t.Fatalf("failed to set encoding: %s", err)
+		}
+		var values []interface{}
+		var dLevels, rLevels []uint16
+		for _, c := range data[col.Index()] {
+			if c.v != nil {
+				values = append(values, c.v)
+			}
+			dLevels = append(dLevels, c.d)
+			rLevels = append(rLevels, c.r)
+		}
+		if err = cw.Write(values, dLevels, rLevels); err != nil {
+			t.Fatalf("failed to write column %s: %s", col, err)
+		}
+		if err = cw.Close(); err != nil {
+			t.Fatalf("failed to close column chunk writer: %s", err)
+		}
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	for _, col := range schema.Columns() {
+		if got := f.MetaData.RowGroups[0].Columns[col.Index()].MetaData.Encodings; !containsEncoding(got, encodings[col.Index()]) {
+			t.Errorf("column %s: got encodings %v", col, got)
+		}
+		cells, err := readCells(f, col, 0)
+		if err != nil {
+			t.Fatalf("failed to read column %s: %s", col, err)
+		}
+		if !reflect.DeepEqual(cells, data[col.Index()]) {
+			t.Errorf("column %s: got %v, want %v", col, cells, data[col.Index()])
+		}
+	}
+
+	fw, err = NewFileWriter(new(bytes.Buffer), schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	cw, err := fw.NewWriter(schema.Columns()[2])
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	if err = cw.SetEncoding(pf.Encoding_DELTA_LENGTH_BYTE_ARRAY); err == nil {
+		t.Errorf("DELTA_LENGTH_BYTE_ARRAY encoding of FIXED_LEN_BYTE_ARRAY values: error expected")
+	}
+}
+
+func TestWriteAllNulls(t *testing.T) {
+	schema := mustCreateSchema(createFileMetaData(
+		&pf.SchemaElement{Name: "Test", NumChildren: int32Ptr(1)},
+		&pf.SchemaElement{Name: "i", Type: typeInt64, RepetitionType: frtOptional},
+	))
+	buf := new(bytes.Buffer)
+	fw, err := NewFileWriter(buf, schema)
+	if err != nil {
+		t.Fatalf("failed to create file writer: %s", err)
+	}
+	if err = fw.StartRowGroup(); err != nil {
+		t.Fatalf("failed to start row group: %s", err)
+	}
+	col := schema.Columns()[0]
+	cw, err := fw.NewWriter(col)
+	if err != nil {
+		t.Fatalf("failed to create column chunk writer: %s", err)
+	}
+	// values are not needed for a batch of nulls
+	if err = cw.Write(nil, []uint16{0, 0}, []uint16{0, 0}); err != nil {
+		t.Fatalf("failed to write nulls: %s", err)
+	}
+	if err = cw.Write(int64(1), []uint16{1}, []uint16{0}); err == nil {
+		t.Errorf("error expected for values that are not a slice")
+	}
+	if err = cw.Write([]int64{1}, []uint16{1}, []uint16{0}); err != nil {
+		t.Fatalf("failed to write: %s", err)
+	}
+	if err = cw.Close(); err != nil {
+		t.Fatalf("failed to close column chunk writer: %s", err)
+	}
+	if err = fw.Close(); err != nil {
+		t.Fatalf("failed to close file writer: %s", err)
+	}
+
+	f, err := FileFromReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+	cells, err := readCells(f, col, 0)
+	if err != nil {
+		t.Fatalf("failed to read: %s", err)
+	}
+	if want := []cell{{0, 0, nil}, {0, 0, nil}, {1, 0, int64(1)}}; !reflect.DeepEqual(cells, want) {
+		t.Errorf("got %v, want %v", cells, want)
+	}
+}
@@ -11,12 +11,65 @@ func newProtocol(r io.Reader) *thrift.TCompactProtocol {
 	return thrift.NewTCompactProtocol(ttransport)
 }
 
+func newWriteProtocol(w io.Writer) *thrift.TCompactProtocol {
+	ttransport := &thrift.StreamTransport{Writer: w}
+	return thrift.NewTCompactProtocol(ttransport)
+}
+
 // FileMetaData.Read reads the object from a io.Reader
 func (meta *FileMetaData) Read(r io.Reader) error {
 	return meta.read(newProtocol(r))
 }
 
+// FileMetaData.Write writes the object to a io.Writer
+func (meta *FileMetaData) Write(w io.Writer) error {
+	return writeStruct(w, meta)
+}
+
 // PageHeader.Read reads the object from a io.Reader
 func (ph *PageHeader) Read(r io.Reader) error {
 	return ph.read(newProtocol(r))
 }
+
+// PageHeader.Write writes the object to a io.Writer
+func (ph *PageHeader) Write(w io.Writer) error {
+	return writeStruct(w, ph)
+}
+
+// ColumnMetaData.Write writes the object to a io.Writer
+func (cmd *ColumnMetaData) Write(w io.Writer) error {
+	return writeStruct(w, cmd)
+}
+
+// ColumnIndex.Read reads the object from a io.Reader
+func (ci *ColumnIndex) Read(r io.Reader) error {
+	return ci.read(newProtocol(r))
+}
+
+// ColumnIndex.Write writes the object to a io.Writer
+func (ci *ColumnIndex) Write(w io.Writer) error {
+	return writeStruct(w, ci)
+}
+
+// OffsetIndex.Read reads the object from a io.Reader
+func (oi *OffsetIndex) Read(r io.Reader) error {
+	return oi.read(newProtocol(r))
+}
+
+// OffsetIndex.Write writes the object to a io.Writer
+func (oi *OffsetIndex) Write(w io.Writer) error {
+	return writeStruct(w, oi)
+}
+
+type thriftWriter interface {
+	write(oprot thrift.TProtocol) error
+}
+
+// writeStruct writes s to w using the compact protocol
+func writeStruct(w io.Writer, s thriftWriter) error {
+	p := newWriteProtocol(w)
+	if err := s.write(p); err != nil {
+		return err
+	}
+	return p.Flush()
+}
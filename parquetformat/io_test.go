@@ -0,0 +1,73 @@
+package parquetformat
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestColumnIndexRoundTrip(t *testing.T) {
+	nullCount := int64(3)
+	ci := &ColumnIndex{
+		NullPages:     []bool{false, true, false},
+		MinValues:     [][]byte{[]byte("a"), {}, []byte("c")},
+		MaxValues:     [][]byte{[]byte("b"), {}, []byte("d")},
+		BoundaryOrder: BoundaryOrder_ASCENDING,
+		NullCounts:    []int64{0, nullCount, 0},
+	}
+	var buf bytes.Buffer
+	if err := ci.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	var got ColumnIndex
+	if err := got.Read(&buf); err != nil {
+		t.Fatalf("Read failed: %s", err)
+	}
+	if !reflect.DeepEqual(&got, ci) {
+		t.Errorf("got %+v, want %+v", &got, ci)
+	}
+}
+
+func TestOffsetIndexRoundTrip(t *testing.T) {
+	oi := &OffsetIndex{
+		PageLocations: []*PageLocation{
+			{Offset: 4, CompressedPageSize: 100, FirstRowIndex: 0},
+			{Offset: 104, CompressedPageSize: 50, FirstRowIndex: 1000},
+		},
+	}
+	var buf bytes.Buffer
+	if err := oi.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	var got OffsetIndex
+	if err := got.Read(&buf); err != nil {
+		t.Fatalf("Read failed: %s", err)
+	}
+	if !reflect.DeepEqual(&got, oi) {
+		t.Errorf("got %+v, want %+v", &got, oi)
+	}
+}
+
+func TestFileMetaDataRoundTrip(t *testing.T) {
+	key, value := "k", "v"
+	meta := &FileMetaData{
+		Version: 1,
+		Schema: []*SchemaElement{
+			{Name: "root", NumChildren: new(int32)},
+		},
+		NumRows:          0,
+		RowGroups:        []*RowGroup{},
+		KeyValueMetadata: []*KeyValue{{Key: key, Value: &value}},
+	}
+	var buf bytes.Buffer
+	if err := meta.Write(&buf); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	var got FileMetaData
+	if err := got.Read(&buf); err != nil {
+		t.Fatalf("Read failed: %s", err)
+	}
+	if !reflect.DeepEqual(&got, meta) {
+		t.Errorf("got %+v, want %+v", &got, meta)
+	}
+}
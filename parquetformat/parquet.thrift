@@ -448,6 +448,18 @@ enum Encoding {
   /** Dictionary encoding: the ids are encoded using the RLE encoding
    */
   RLE_DICTIONARY = 8;
+
+  /** Encoding for fixed-width data (FLOAT, DOUBLE, INT32, INT64, FIXED_LEN_BYTE_ARRAY).
+      K byte-streams are created where K is the size in bytes of the data type.
+      The individual bytes of a value are scattered to the corresponding stream and
+      the streams are concatenated.
+      This itself does not reduce the size of the data but can lead to better compression
+      afterwards.
+
+      Added in 2.8 for FLOAT and DOUBLE.
+      Support for INT32, INT64 and FIXED_LEN_BYTE_ARRAY added in 2.11.
+   */
+  BYTE_STREAM_SPLIT = 9;
 }
 
 /**
@@ -464,8 +476,9 @@ enum CompressionCodec {
   GZIP = 2;
   LZO = 3;
   BROTLI = 4; // Added in 2.4
-  LZ4 = 5;    // Added in 2.4
+  LZ4 = 5;    // DEPRECATED (Added in 2.4)
   ZSTD = 6;   // Added in 2.4
+  LZ4_RAW = 7; // Added in 2.9
 }
 
 enum PageType {
@@ -299,6 +299,7 @@ const (
 	Encoding_DELTA_LENGTH_BYTE_ARRAY Encoding = 6
 	Encoding_DELTA_BYTE_ARRAY        Encoding = 7
 	Encoding_RLE_DICTIONARY          Encoding = 8
+	Encoding_BYTE_STREAM_SPLIT       Encoding = 9
 )
 
 func (p Encoding) String() string {
@@ -319,6 +320,8 @@ func (p Encoding) String() string {
 		return "DELTA_BYTE_ARRAY"
 	case Encoding_RLE_DICTIONARY:
 		return "RLE_DICTIONARY"
+	case Encoding_BYTE_STREAM_SPLIT:
+		return "BYTE_STREAM_SPLIT"
 	}
 	return "<UNSET>"
 }
@@ -341,6 +344,8 @@ func EncodingFromString(s string) (Encoding, error) {
 		return Encoding_DELTA_BYTE_ARRAY, nil
 	case "RLE_DICTIONARY":
 		return Encoding_RLE_DICTIONARY, nil
+	case "BYTE_STREAM_SPLIT":
+		return Encoding_BYTE_STREAM_SPLIT, nil
 	}
 	return Encoding(0), fmt.Errorf("not a valid Encoding string")
 }
@@ -376,6 +381,7 @@ const (
 	CompressionCodec_BROTLI       CompressionCodec = 4
 	CompressionCodec_LZ4          CompressionCodec = 5
 	CompressionCodec_ZSTD         CompressionCodec = 6
+	CompressionCodec_LZ4_RAW      CompressionCodec = 7
 )
 
 func (p CompressionCodec) String() string {
@@ -394,6 +400,8 @@ func (p CompressionCodec) String() string {
 		return "LZ4"
 	case CompressionCodec_ZSTD:
 		return "ZSTD"
+	case CompressionCodec_LZ4_RAW:
+		return "LZ4_RAW"
 	}
 	return "<UNSET>"
 }
@@ -414,6 +422,8 @@ func CompressionCodecFromString(s string) (CompressionCodec, error) {
 		return CompressionCodec_LZ4, nil
 	case "ZSTD":
 		return CompressionCodec_ZSTD, nil
+	case "LZ4_RAW":
+		return CompressionCodec_LZ4_RAW, nil
 	}
 	return CompressionCodec(0), fmt.Errorf("not a valid CompressionCodec string")
 }